@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var blueprintCmd = &cobra.Command{
+	Use:   "blueprints",
+	Short: "Manage Blueprints",
+	Long: `Manage Blueprints, the render.yaml files that define infrastructure as code.
+In interactive mode you can view the resources a Blueprint manages and its sync history.`,
+	GroupID: GroupCore.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(blueprintCmd)
+	blueprintCmd.AddCommand(blueprintListCmd, blueprintShowCmd, blueprintSyncsCmd, blueprintDisconnectCmd, blueprintAutoSyncCmd, blueprintValidateCmd, blueprintExportCmd, blueprintPlanCmd)
+}
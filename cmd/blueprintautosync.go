@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var blueprintAutoSyncCmd = &cobra.Command{
+	Use:       "set-autosync [blueprintID] [on|off]",
+	Short:     "Turn automatic syncing of render.yaml changes on or off",
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: []string{"on", "off"},
+}
+
+var InteractiveBlueprintAutoSync = func(ctx context.Context, input views.BlueprintAutoSyncInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, blueprintAutoSyncCmd, breadcrumb, &input, views.NewBlueprintAutoSyncView(ctx, input))
+}
+
+func init() {
+	blueprintAutoSyncCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintAutoSyncInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientblueprints.Blueprint, error) {
+			return views.SetBlueprintAutoSync(cmd.Context(), input)
+		}, func(b *clientblueprints.Blueprint) string {
+			return text.FormatString(views.AutoSyncMessage(b))
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveBlueprintAutoSync(cmd.Context(), input, "Auto Sync")
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var blueprintDisconnectCmd = &cobra.Command{
+	Use:   "disconnect [blueprintID]",
+	Short: "Disconnect a Blueprint",
+	Long: `Disconnect a Blueprint. The resources it manages are kept, but changes to render.yaml will no longer be
+applied to them.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveBlueprintDisconnect = func(ctx context.Context, input views.BlueprintInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, blueprintDisconnectCmd, breadcrumb, &input, views.NewBlueprintDisconnectView(ctx, input))
+}
+
+func init() {
+	blueprintDisconnectCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.DisconnectBlueprint(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForDisconnectBlueprint(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveBlueprintDisconnect(cmd.Context(), input, "Disconnect")
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var blueprintExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Generate a render.yaml from existing services and datastores",
+	Long: `Generate a render.yaml Blueprint that reproduces services and datastores created in the Dashboard, so they
+can be managed as code.
+
+Exports every resource in the workspace unless --project or --environment-ids is set. Service settings, disks,
+custom domains, env vars, env group links, and the routes and headers of static sites are included. Postgres
+databases are exported to databases and Key Value instances to keyvalue services.
+
+Use --names to export only the resources and env groups with those names.
+
+Env var values are not exported by default. Each env var becomes a sync: false placeholder that you are prompted
+for when the Blueprint is first synced. Use --include-values to export values, env vars that look like secrets
+are still exported as placeholders. Use --include-secrets to export those too, and keep the file out of version
+control.
+
+Resources are always exported at the top level of the file, even when they belong to a project. The file has no
+projects section, so syncing it doesn't recreate the project and environment grouping. Move the resources under
+projects and environments by hand to keep it.
+
+Prints the Blueprint to stdout unless --out is set.`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	blueprintExportCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintExportInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		formatText := text.BlueprintSpec
+		if input.Out != "" {
+			formatText = text.BlueprintExport(input.Out)
+		}
+
+		command.DefaultFormatNonInteractive(cmd)
+		_, err = command.NonInteractive(cmd, func() (*blueprint.Spec, error) {
+			return views.ExportBlueprint(cmd.Context(), input)
+		}, formatText)
+		return err
+	}
+
+	blueprintExportCmd.Flags().String("project", "", "ID or name of the project to export")
+	blueprintExportCmd.Flags().StringSliceP("environment-ids", "e", nil, "Comma separated list of environment ids to export")
+	blueprintExportCmd.Flags().StringSlice("names", nil, "Comma separated list of resource and env group names to export")
+	blueprintExportCmd.Flags().Bool("include-values", false, "Export env var values that don't look like secrets")
+	blueprintExportCmd.Flags().Bool("include-secrets", false, "Export all env var values, including ones that look like secrets")
+	blueprintExportCmd.Flags().String("out", "", "Path of the file to write the Blueprint to")
+	blueprintExportCmd.MarkFlagsMutuallyExclusive("project", "environment-ids")
+}
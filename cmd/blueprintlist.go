@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var blueprintListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Blueprints in the active workspace",
+	Args:  cobra.NoArgs,
+}
+
+var InteractiveBlueprintList = func(ctx context.Context, input views.BlueprintListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, blueprintListCmd, breadcrumb, &input, views.NewBlueprintList(ctx, input,
+		func(ctx context.Context, b *clientblueprints.Blueprint) tea.Cmd {
+			return InteractivePalette(ctx, commandsForBlueprint(b), b.Name)
+		},
+		tui.WithCustomOptions[*clientblueprints.Blueprint]([]tui.CustomOption{
+			WithCopyID(ctx, blueprintCmd),
+			WithWorkspaceSelection(ctx),
+		}),
+	))
+}
+
+func commandsForBlueprint(b *clientblueprints.Blueprint) []views.PaletteCommand {
+	autoSync := "on"
+	autoSyncDescription := "Automatically sync changes to render.yaml"
+	if b.AutoSync {
+		autoSync = "off"
+		autoSyncDescription = "Stop automatically syncing changes to render.yaml"
+	}
+
+	return []views.PaletteCommand{
+		{
+			Name:        "show",
+			Description: "Show the resources managed by the Blueprint",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveBlueprintShow(ctx, views.BlueprintInput{BlueprintID: b.Id}, "Blueprint "+b.Name)
+			},
+		},
+		{
+			Name:        "syncs",
+			Description: "Show the sync history of the Blueprint",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveBlueprintSyncs(ctx, views.BlueprintInput{BlueprintID: b.Id}, "Syncs")
+			},
+		},
+		{
+			Name:        "set-autosync " + autoSync,
+			Description: autoSyncDescription,
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveBlueprintAutoSync(ctx, views.BlueprintAutoSyncInput{BlueprintID: b.Id, Enabled: autoSync}, "Auto Sync")
+			},
+		},
+		{
+			Name:        "disconnect",
+			Description: "Disconnect the Blueprint, keeping its resources",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveBlueprintDisconnect(ctx, views.BlueprintInput{BlueprintID: b.Id}, "Disconnect")
+			},
+		},
+	}
+}
+
+func init() {
+	blueprintListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*clientblueprints.Blueprint, error) {
+			return views.LoadBlueprints(cmd.Context(), input)
+		}, text.BlueprintTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveBlueprintList(cmd.Context(), input, "Blueprints")
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+// planDriftExitCode is returned when live resources don't match the file, matching terraform plan -detailed-exitcode
+const planDriftExitCode = 2
+
+var blueprintPlanCmd = &cobra.Command{
+	Use:   "plan [file]",
+	Short: "Show how live resources differ from a render.yaml file",
+	Long: `Compare a render.yaml file with the live resources in the workspace and print the resources and fields the
+next Blueprint sync would create, update or delete. Use it in CI to catch changes made in the Dashboard before
+a sync overwrites them.
+
+Only fields set in the file are compared, since unset fields use defaults. Env var values are never printed.
+Values set with sync: false, generateValue, fromService or fromDatabase are only checked for existence.
+Resources declared under projects are compared by name together with the top-level resources, whatever
+environment they belong to.
+
+Resources missing from the file are reported as deletes only when --blueprint, --project or --environment-ids is
+set. With --blueprint, the resources the Blueprint currently manages are compared.
+
+Defaults to render.yaml in the current directory. The file is validated first. If it is invalid, the validation
+result is printed in the requested output format instead of a plan.
+
+Exit codes:
+  0  Live resources match the file
+  1  The file is invalid or the plan failed
+  2  Live resources differ from the file`,
+	Args: cobra.MaximumNArgs(1),
+}
+
+func init() {
+	blueprintPlanCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintPlanInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+		if input.File == "" {
+			input.File = "render.yaml"
+		}
+
+		data, err := os.ReadFile(input.File)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+
+		command.DefaultFormatNonInteractive(cmd)
+
+		// an invalid file is reported in the requested output format, like blueprints validate does
+		if res := blueprint.Validate(input.File, data); !res.Valid {
+			if _, err := command.NonInteractive(cmd, func() (*blueprint.Result, error) {
+				return res, nil
+			}, text.BlueprintValidation); err != nil {
+				return err
+			}
+			os.Exit(1)
+		}
+
+		desired, err := blueprint.ParseSpec(data)
+		if err != nil {
+			return err
+		}
+
+		var plan *blueprint.Plan
+		if _, err := command.NonInteractive(cmd, func() (*blueprint.Plan, error) {
+			plan, err = views.PlanBlueprint(cmd.Context(), input, desired)
+			return plan, err
+		}, text.BlueprintPlan); err != nil {
+			return err
+		}
+
+		if plan.HasDrift() {
+			os.Exit(planDriftExitCode)
+		}
+		return nil
+	}
+
+	blueprintPlanCmd.Flags().String("blueprint", "", "ID of the Blueprint that manages the resources")
+	blueprintPlanCmd.Flags().String("project", "", "ID or name of the project the resources are in")
+	blueprintPlanCmd.Flags().StringSliceP("environment-ids", "e", nil, "Comma separated list of environment ids the resources are in")
+	blueprintPlanCmd.MarkFlagsMutuallyExclusive("blueprint", "project", "environment-ids")
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var blueprintShowCmd = &cobra.Command{
+	Use:   "show [blueprintID]",
+	Short: "Show a Blueprint and the resources it manages",
+	Long: `Show a Blueprint and the resources it manages.
+In interactive mode, selecting a service or datastore opens the same commands as the services view.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveBlueprintShow = func(ctx context.Context, input views.BlueprintInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, blueprintShowCmd, breadcrumb, &input, views.NewBlueprintResourceList(ctx, input,
+		func(ctx context.Context, ref clientblueprints.ResourceRef) tea.Cmd {
+			if ref.Type == clientblueprints.EnvironmentGroup {
+				return nil
+			}
+
+			r, err := resource.GetResource(ctx, ref.Id)
+			if err != nil {
+				return command.AddErrToStack(ctx, blueprintShowCmd, err)
+			}
+			return InteractivePalette(ctx, selectResource(ctx)(r), resource.BreadcrumbForResource(r))
+		},
+		tui.WithCustomOptions[clientblueprints.ResourceRef]([]tui.CustomOption{
+			WithCopyID(ctx, blueprintCmd),
+		}),
+	))
+}
+
+func init() {
+	blueprintShowCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientblueprints.BlueprintDetail, error) {
+			return views.LoadBlueprint(cmd.Context(), input)
+		}, text.Blueprint); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveBlueprintShow(cmd.Context(), input, "Blueprint "+input.BlueprintID)
+		return nil
+	}
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var blueprintSyncsCmd = &cobra.Command{
+	Use:   "syncs [blueprintID]",
+	Short: "List the sync history of a Blueprint",
+	Long:  `List the syncs of a Blueprint with the commit, state and timing of each sync.`,
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveBlueprintSyncs = func(ctx context.Context, input views.BlueprintInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, blueprintSyncsCmd, breadcrumb, &input, views.NewBlueprintSyncList(ctx, input))
+}
+
+func init() {
+	blueprintSyncsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.BlueprintInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*clientblueprints.Sync, error) {
+			return views.LoadBlueprintSyncs(cmd.Context(), input)
+		}, text.BlueprintSyncTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveBlueprintSyncs(cmd.Context(), input, "Syncs")
+		return nil
+	}
+}
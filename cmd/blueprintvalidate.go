@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+)
+
+var blueprintValidateCmd = &cobra.Command{
+	Use:   "validate [file]",
+	Short: "Validate a render.yaml file without syncing it",
+	Long: `Check a render.yaml file for mistakes before pushing it. Validation runs locally and does not require
+you to be logged in.
+
+Checks include service types, runtimes, plans and regions, cron schedules, disks, duplicate names and env vars,
+and fromService, fromDatabase and fromGroup references. Each issue is reported with its line and column.
+References to resources not defined in the file are reported as warnings since they may exist outside the Blueprint.
+
+Defaults to render.yaml in the current directory. Exits with status 1 if any errors are found.`,
+	Args: cobra.MaximumNArgs(1),
+}
+
+func init() {
+	blueprintValidateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		file := "render.yaml"
+		if len(args) > 0 {
+			file = args[0]
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+
+		res := blueprint.Validate(file, data)
+
+		command.DefaultFormatNonInteractive(cmd)
+		if _, err := command.NonInteractive(cmd, func() (*blueprint.Result, error) {
+			return res, nil
+		}, text.BlueprintValidation); err != nil {
+			return err
+		}
+
+		if !res.Valid {
+			os.Exit(1)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var cronCmd = &cobra.Command{
+	Use:     "cron",
+	Short:   "Manage cron job runs",
+	GroupID: GroupCore.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(cronCmd)
+	cronCmd.AddCommand(cronRunCmd, cronCancelCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var cronCancelCmd = &cobra.Command{
+	Use:   "cancel [cronJobID]",
+	Short: "Cancel the active run of a cron job",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveCronCancel = func(ctx context.Context, input views.CronCancelInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, cronCancelCmd, breadcrumb, &input, views.NewCronCancelView(ctx, input))
+}
+
+func init() {
+	cronCancelCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.CronCancelInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.CancelCronJobRun(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForCancelCronJobRun(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveCronCancel(cmd.Context(), input, "Cancel run")
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/cron"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var cronRunCmd = &cobra.Command{
+	Use:   "run [cronJobID]",
+	Short: "Trigger a cron job run and tail logs",
+	Long: `Trigger a run of a cron job outside of its schedule.
+
+Set --wait to stream the run's logs to stderr and wait for it to finish. The command returns a non-zero exit code
+if the run fails or is canceled.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveCronRun = func(ctx context.Context, input views.CronRunInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, cronRunCmd, breadcrumb, &input, views.NewCronRunView(ctx, input, func() tea.Cmd {
+		return TailResourceLogs(ctx, input.CronJobID)
+	}))
+}
+
+func init() {
+	cronRunCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.CronRunInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		// if wait flag is used, default to non-interactive output
+		if input.Wait {
+			command.DefaultFormatNonInteractive(cmd)
+		}
+
+		if nonInteractiveCronRun(cmd, input) {
+			return nil
+		}
+
+		r, err := resource.GetResource(cmd.Context(), input.CronJobID)
+		if err != nil {
+			return err
+		}
+		InteractiveCronRun(cmd.Context(), input, "Run "+resource.BreadcrumbForResource(r))
+		return nil
+	}
+
+	cronRunCmd.Flags().Bool("wait", false, "Tail logs and wait for the run to finish. Returns non-zero exit code if the run fails")
+}
+
+func nonInteractiveCronRun(cmd *cobra.Command, input views.CronRunInput) bool {
+	var run *client.CronJobRun
+	runCronJob := func() (*client.CronJobRun, error) {
+		since := time.Now().Add(-time.Minute)
+
+		r, err := views.RunCronJob(cmd.Context(), input)
+		if err != nil || !input.Wait {
+			return r, err
+		}
+
+		_, err = fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for run %s to complete...\n\n", r.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		stopTail, err := tailLogsToStderr(cmd, input.CronJobID, since)
+		if err != nil {
+			return nil, err
+		}
+		defer stopTail()
+
+		run, err = views.WaitForCronJobRun(cmd.Context(), input.CronJobID, r, since)
+		return run, err
+	}
+
+	confirmRun := func() (string, error) {
+		return views.RequireConfirmationForRunCronJob(cmd.Context(), input)
+	}
+
+	nonInteractive, err := command.NonInteractiveWithConfirm(cmd, runCronJob, text.CronJobRun(input.CronJobID), confirmRun)
+	if err != nil {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+		os.Exit(1)
+	}
+	if !nonInteractive {
+		return false
+	}
+
+	if input.Wait && !cron.IsSuccessful(run.Status) {
+		os.Exit(1)
+	}
+
+	return true
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var diskCmd = &cobra.Command{
+	Use:   "disks",
+	Short: "Manage persistent disks",
+	Long: `Manage persistent disks attached to services in the active workspace.
+In interactive mode you can view a disk's snapshots and restore or delete it.`,
+	GroupID: GroupCore.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(diskCmd)
+	diskCmd.AddCommand(diskListCmd, diskShowCmd, diskAddCmd, diskResizeCmd, diskDeleteCmd, diskSnapshotCmd)
+}
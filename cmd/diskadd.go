@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var diskAddCmd = &cobra.Command{
+	Use:   "add [serviceID]",
+	Short: "Attach a new persistent disk to a service",
+	Args:  cobra.MaximumNArgs(1),
+}
+
+var InteractiveDiskAdd = func(ctx context.Context, input *views.DiskCreateInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(
+		ctx,
+		diskAddCmd,
+		breadcrumb,
+		input,
+		views.NewDiskCreateView(ctx, input, diskAddCmd, views.CreateDisk, func(d *clientdisks.DiskDetails) tea.Cmd {
+			return InteractiveDiskShow(ctx, views.DiskInput{DiskID: d.Id}, "Disk "+d.Name)
+		}),
+	)
+}
+
+func interactiveDiskAdd(cmd *cobra.Command, input *views.DiskCreateInput) tea.Cmd {
+	ctx := cmd.Context()
+	if input.ServiceID == "" {
+		return command.AddToStackFunc(
+			ctx,
+			cmd,
+			"Add Disk",
+			input,
+			views.NewServiceList(ctx, views.ServiceInput{
+				Types: []client.ServiceType{
+					client.WebService, client.BackgroundWorker, client.PrivateService,
+				},
+			}, func(ctx context.Context, r resource.Resource) tea.Cmd {
+				input.ServiceID = r.ID()
+				return InteractiveDiskAdd(ctx, input, resource.BreadcrumbForResource(r))
+			}),
+		)
+	}
+
+	service, err := resource.GetResource(ctx, input.ServiceID)
+	if err != nil {
+		command.Fatal(cmd, err)
+	}
+
+	return InteractiveDiskAdd(ctx, input, "Add Disk to "+resource.BreadcrumbForResource(service))
+}
+
+func init() {
+	diskAddCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskCreateInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientdisks.DiskDetails, error) {
+			return views.CreateDisk(cmd.Context(), input)
+		}, func(d *clientdisks.DiskDetails) string {
+			return text.FormatStringF("Created disk %s (%s) mounted at %s for %s", d.Name, d.Id, d.MountPath, input.ServiceID)
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		interactiveDiskAdd(cmd, &input)
+		return nil
+	}
+
+	diskAddCmd.Flags().String("name", "", "The name of the disk")
+	diskAddCmd.Flags().String("mount-path", "", "The absolute path where the disk is mounted, e.g. /var/data")
+	diskAddCmd.Flags().Int("size", 10, "The size of the disk in GB")
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var diskDeleteCmd = &cobra.Command{
+	Use:   "delete [diskID]",
+	Short: "Delete a disk and all of its data",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveDiskDelete = func(ctx context.Context, input views.DiskDeleteInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, diskDeleteCmd, breadcrumb, &input, views.NewDiskDeleteView(ctx, input))
+}
+
+func init() {
+	diskDeleteCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskDeleteInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.DeleteDisk(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForDeleteDisk(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDiskDelete(cmd.Context(), input, "Delete disk "+input.DiskID)
+		return nil
+	}
+}
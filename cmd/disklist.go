@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var diskListCmd = &cobra.Command{
+	Use:   "list [serviceID]",
+	Short: "List disks, optionally filtered to a single service",
+	Args:  cobra.MaximumNArgs(1),
+}
+
+var InteractiveDiskList = func(ctx context.Context, input views.DiskListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, diskListCmd, breadcrumb, &input, views.NewDiskList(ctx, input,
+		func(ctx context.Context, d *clientdisks.DiskDetails) tea.Cmd {
+			return InteractivePalette(ctx, commandsForDisk(d), d.Name)
+		},
+		tui.WithCustomOptions[*clientdisks.DiskDetails]([]tui.CustomOption{
+			WithCopyID(ctx, diskCmd),
+			WithWorkspaceSelection(ctx),
+		}),
+	))
+}
+
+func commandsForDisk(d *clientdisks.DiskDetails) []views.PaletteCommand {
+	return []views.PaletteCommand{
+		{
+			Name:        "show",
+			Description: "Show disk details",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveDiskShow(ctx, views.DiskInput{DiskID: d.Id}, "Disk")
+			},
+		},
+		{
+			Name:        "snapshots",
+			Description: "List and restore disk snapshots",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveDiskSnapshotList(ctx, views.DiskSnapshotListInput{DiskID: d.Id}, "Snapshots")
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the disk",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveDiskDelete(ctx, views.DiskDeleteInput{DiskID: d.Id}, "Delete disk")
+			},
+		},
+	}
+}
+
+func init() {
+	diskListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*clientdisks.DiskDetails, error) {
+			return views.LoadDisks(cmd.Context(), input)
+		}, text.DiskTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDiskList(cmd.Context(), input, "Disks")
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var diskResizeCmd = &cobra.Command{
+	Use:   "resize [diskID]",
+	Short: "Increase the size of a disk",
+	Long: `Increase the size of a disk. The current and new sizes are shown for confirmation before the resize.
+Disk size cannot be decreased.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveDiskResize = func(ctx context.Context, input views.DiskResizeInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, diskResizeCmd, breadcrumb, &input, views.NewDiskResizeView(ctx, input))
+}
+
+func init() {
+	diskResizeCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskResizeInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.ResizeDisk(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForResizeDisk(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDiskResize(cmd.Context(), input, "Resize disk "+input.DiskID)
+		return nil
+	}
+
+	diskResizeCmd.Flags().Int("size", 0, "The new size of the disk in GB")
+	if err := diskResizeCmd.MarkFlagRequired("size"); err != nil {
+		panic(err)
+	}
+}
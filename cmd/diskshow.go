@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var diskShowCmd = &cobra.Command{
+	Use:   "show [diskID]",
+	Short: "Show details for a disk",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveDiskShow = func(ctx context.Context, input views.DiskInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, diskShowCmd, breadcrumb, &input, views.NewDiskView(ctx, input))
+}
+
+func init() {
+	diskShowCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientdisks.DiskDetails, error) {
+			return views.LoadDisk(cmd.Context(), input)
+		}, text.Disk); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDiskShow(cmd.Context(), input, "Disk "+input.DiskID)
+		return nil
+	}
+}
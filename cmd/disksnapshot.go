@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var diskSnapshotCmd = &cobra.Command{
+	Use:   "snapshots",
+	Short: "List and restore disk snapshots",
+}
+
+var diskSnapshotListCmd = &cobra.Command{
+	Use:   "list [diskID]",
+	Short: "List snapshots for a disk",
+	Long: `List snapshots for a disk.
+In interactive mode you can select a snapshot to restore it.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var diskSnapshotRestoreCmd = &cobra.Command{
+	Use:   "restore [diskID]",
+	Short: "Restore a disk from a snapshot",
+	Long: `Restore a disk from a snapshot. This replaces all current data on the disk with the contents of the snapshot.
+Any data written after the snapshot was taken is permanently lost.
+
+Use 'render disks snapshots list' to find snapshot keys.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveDiskSnapshotList = func(ctx context.Context, input views.DiskSnapshotListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, diskSnapshotListCmd, breadcrumb, &input, views.NewDiskSnapshotList(ctx, input,
+		func(ctx context.Context, s client.DiskSnapshot) tea.Cmd {
+			return InteractiveDiskSnapshotRestore(ctx, views.DiskSnapshotRestoreInput{
+				DiskID:      input.DiskID,
+				SnapshotKey: pointers.StringValue(s.SnapshotKey),
+				InstanceID:  pointers.StringValue(s.InstanceId),
+			}, "Restore snapshot")
+		},
+	))
+}
+
+var InteractiveDiskSnapshotRestore = func(ctx context.Context, input views.DiskSnapshotRestoreInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, diskSnapshotRestoreCmd, breadcrumb, &input, views.NewDiskSnapshotRestoreView(ctx, input))
+}
+
+func init() {
+	diskSnapshotCmd.AddCommand(diskSnapshotListCmd, diskSnapshotRestoreCmd)
+
+	diskSnapshotListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskSnapshotListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]client.DiskSnapshot, error) {
+			return views.LoadDiskSnapshots(cmd.Context(), input)
+		}, text.SnapshotTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDiskSnapshotList(cmd.Context(), input, "Snapshots for "+input.DiskID)
+		return nil
+	}
+
+	diskSnapshotRestoreCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DiskSnapshotRestoreInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.RestoreDiskSnapshot(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForRestoreDiskSnapshot(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDiskSnapshotRestore(cmd.Context(), input, "Restore snapshot "+input.SnapshotKey)
+		return nil
+	}
+
+	diskSnapshotRestoreCmd.Flags().String("snapshot-key", "", "The key of the snapshot to restore")
+	diskSnapshotRestoreCmd.Flags().String("instance-id", "", "The instance whose disk should be restored (only for scaled services)")
+	if err := diskSnapshotRestoreCmd.MarkFlagRequired("snapshot-key"); err != nil {
+		panic(err)
+	}
+}
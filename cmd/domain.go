@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var domainCmd = &cobra.Command{
+	Use:   "domains",
+	Short: "Manage custom domains",
+	Long: `Manage custom domains for web services and static sites.
+Adding a domain prints the DNS records to create with your DNS provider. Verifying a domain checks those records
+locally before asking Render to verify it.`,
+	GroupID: GroupCore.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(domainCmd)
+	domainCmd.AddCommand(domainListCmd, domainAddCmd, domainRemoveCmd, domainVerifyCmd)
+}
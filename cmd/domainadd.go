@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var domainAddCmd = &cobra.Command{
+	Use:   "add [serviceID] [domain]",
+	Short: "Add a custom domain to a service",
+	Long: `Add a custom domain to a service and print the DNS records to create with your DNS provider.
+Adding an apex domain such as example.com also adds www.example.com, which redirects to it.`,
+	Args: cobra.ExactArgs(2),
+}
+
+var InteractiveDomainAdd = func(ctx context.Context, input views.DomainAddInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, domainAddCmd, breadcrumb, &input, views.NewDomainAddView(ctx, input))
+}
+
+func init() {
+	domainAddCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DomainAddInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]domain.WithRecords, error) {
+			return views.AddDomain(cmd.Context(), input)
+		}, text.DomainRecords); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDomainAdd(cmd.Context(), input, "Add "+input.Name)
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var domainListCmd = &cobra.Command{
+	Use:   "list [serviceID]",
+	Short: "List custom domains for a service",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveDomainList = func(ctx context.Context, input views.DomainListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, domainListCmd, breadcrumb, &input, views.NewDomainList(ctx, input,
+		func(ctx context.Context, d *client.CustomDomain) tea.Cmd {
+			return InteractivePalette(ctx, commandsForDomain(input.ServiceID, d), d.Name)
+		},
+		tui.WithCustomOptions[*client.CustomDomain]([]tui.CustomOption{
+			WithCopyID(ctx, domainCmd),
+		}),
+	))
+}
+
+func commandsForDomain(serviceID string, d *client.CustomDomain) []views.PaletteCommand {
+	return []views.PaletteCommand{
+		{
+			Name:        "verify",
+			Description: "Check DNS records and verify the domain",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveDomainVerify(ctx, views.DomainVerifyInput{ServiceID: serviceID, Domain: d.Name}, "Verify "+d.Name)
+			},
+		},
+		{
+			Name:        "remove",
+			Description: "Remove the domain from the service",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveDomainRemove(ctx, views.DomainInput{ServiceID: serviceID, Domain: d.Name}, "Remove "+d.Name)
+			},
+		},
+	}
+}
+
+func init() {
+	domainListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DomainListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*client.CustomDomain, error) {
+			return views.LoadDomains(cmd.Context(), input)
+		}, text.DomainTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDomainList(cmd.Context(), input, "Domains")
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var domainRemoveCmd = &cobra.Command{
+	Use:   "remove [serviceID] [domain]",
+	Short: "Remove a custom domain from a service",
+	Args:  cobra.ExactArgs(2),
+}
+
+var InteractiveDomainRemove = func(ctx context.Context, input views.DomainInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, domainRemoveCmd, breadcrumb, &input, views.NewDomainRemoveView(ctx, input))
+}
+
+func init() {
+	domainRemoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DomainInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.RemoveDomain(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForRemoveDomain(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDomainRemove(cmd.Context(), input, "Remove "+input.Domain)
+		return nil
+	}
+}
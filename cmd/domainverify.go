@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var domainVerifyCmd = &cobra.Command{
+	Use:   "verify [serviceID] [domain]",
+	Short: "Check DNS records and verify a custom domain",
+	Long: `Check a custom domain's DNS records and verify it.
+The records are first looked up locally and compared against the records Render expects. If they match, Render is
+asked to re-check the domain and the command waits until the domain is verified.`,
+	Args: cobra.ExactArgs(2),
+}
+
+var InteractiveDomainVerify = func(ctx context.Context, input views.DomainVerifyInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, domainVerifyCmd, breadcrumb, &input, views.NewDomainVerifyView(ctx, input))
+}
+
+func init() {
+	domainVerifyCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.DomainVerifyInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*domain.Verification, error) {
+			return views.VerifyDomain(cmd.Context(), input)
+		}, text.DomainVerification); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveDomainVerify(cmd.Context(), input, "Verify "+input.Domain)
+		return nil
+	}
+
+	domainVerifyCmd.Flags().Bool("skip-dns-check", false, "Ask Render to verify the domain even if the local DNS lookup does not match")
+}
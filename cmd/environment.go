@@ -4,6 +4,7 @@ import (
 	"context"
 
 	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
 	"github.com/spf13/cobra"
 
 	"github.com/renderinc/cli/pkg/client"
@@ -19,7 +20,8 @@ var environmentCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	Short: "List environments",
 	Long: `List environments for a specified project in the active workspace.
-In interactive mode you can view each environment's individual services.`,
+In interactive mode you can view each environment's individual services, and create, update,
+delete or move resources to environments.`,
 	GroupID: GroupManagement.ID,
 }
 
@@ -33,12 +35,43 @@ var InteractiveEnvironment = func(ctx context.Context, input views.EnvironmentIn
 		tui.WithCustomOptions[*client.Environment]([]tui.CustomOption{
 			WithCopyID(ctx, servicesCmd),
 			WithWorkspaceSelection(ctx),
+			{
+				Key:   "n",
+				Title: "New Environment",
+				Function: func(row btable.Row) tea.Cmd {
+					return InteractiveEnvironmentCreate(ctx, &views.EnvironmentCreateInput{ProjectID: input.ProjectID}, "Create Environment")
+				},
+			},
+			withEnvironment("e", "Edit", func(e *client.Environment) tea.Cmd {
+				return InteractiveEnvironmentUpdate(ctx, &views.EnvironmentUpdateInput{EnvironmentID: e.Id}, "Update "+e.Name)
+			}),
+			withEnvironment("m", "Move Resources Here", func(e *client.Environment) tea.Cmd {
+				return InteractiveEnvironmentMovePicker(ctx, views.EnvironmentMoveInput{EnvironmentID: e.Id}, "Move to "+e.Name)
+			}),
+			withEnvironment("d", "Delete", func(e *client.Environment) tea.Cmd {
+				return InteractiveEnvironmentDelete(ctx, views.EnvironmentDeleteInput{EnvironmentID: e.Id}, "Delete "+e.Name)
+			}),
 		}),
 	))
 }
 
+func withEnvironment(key, title string, f func(e *client.Environment) tea.Cmd) tui.CustomOption {
+	return tui.CustomOption{
+		Key:   key,
+		Title: title,
+		Function: func(row btable.Row) tea.Cmd {
+			e, ok := row.Data["environment"].(*client.Environment)
+			if !ok {
+				return nil
+			}
+			return f(e)
+		},
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(environmentCmd)
+	environmentCmd.AddCommand(environmentCreateCmd, environmentUpdateCmd, environmentDeleteCmd, environmentMoveCmd)
 
 	environmentCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		var input views.EnvironmentInput
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/environment"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var environmentCreateCmd = &cobra.Command{
+	Use:   "create [projectID]",
+	Short: "Create an environment in a project",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveEnvironmentCreate = func(ctx context.Context, input *views.EnvironmentCreateInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, environmentCreateCmd, breadcrumb, input, views.NewEnvironmentCreateView(ctx, input, environmentCreateCmd, func(env *client.Environment) tea.Cmd {
+		return command.ShowResultFunc(ctx, environmentCreateCmd, "Created", input)(environmentCreatedMessage(env))
+	}))
+}
+
+func init() {
+	protectedStatusFlag := command.NewEnumInput(environment.ProtectedStatuses, false)
+
+	environmentCreateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.EnvironmentCreateInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.Environment, error) {
+			return views.CreateEnvironment(cmd.Context(), input)
+		}, func(env *client.Environment) string {
+			return text.FormatString(environmentCreatedMessage(env))
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveEnvironmentCreate(cmd.Context(), &input, "Create Environment")
+		return nil
+	}
+
+	environmentCreateCmd.Flags().String("name", "", "The name of the environment")
+	environmentCreateCmd.Flags().Var(protectedStatusFlag, "protected-status", "Only admins can perform destructive actions in protected environments. Defaults to unprotected")
+}
+
+func environmentCreatedMessage(env *client.Environment) string {
+	return fmt.Sprintf("Created environment %s (%s)", env.Name, env.Id)
+}
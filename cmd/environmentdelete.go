@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var environmentDeleteCmd = &cobra.Command{
+	Use:   "delete [environmentID]",
+	Short: "Delete an environment",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveEnvironmentDelete = func(ctx context.Context, input views.EnvironmentDeleteInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, environmentDeleteCmd, breadcrumb, &input, views.NewEnvironmentDeleteView(ctx, input))
+}
+
+func init() {
+	environmentDeleteCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.EnvironmentDeleteInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.DeleteEnvironment(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForDeleteEnvironment(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveEnvironmentDelete(cmd.Context(), input, "Delete "+input.EnvironmentID)
+		return nil
+	}
+}
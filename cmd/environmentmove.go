@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var environmentMoveCmd = &cobra.Command{
+	Use:   "move [environmentID]",
+	Short: "Move services and datastores to an environment",
+	Long: `Move services and datastores to an environment. Resources are removed from their current environment.
+In interactive mode you can pick the resources to move if --resources is not set.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveEnvironmentMove = func(ctx context.Context, input views.EnvironmentMoveInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, environmentMoveCmd, breadcrumb, &input, views.NewEnvironmentMoveView(ctx, input))
+}
+
+// InteractiveEnvironmentMovePicker lists resources to select with space, then confirms moving them
+var InteractiveEnvironmentMovePicker = func(ctx context.Context, input views.EnvironmentMoveInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, environmentMoveCmd, breadcrumb, &input,
+		views.NewResourcePickerView(ctx, input, func(ids []string) tea.Cmd {
+			return InteractiveEnvironmentMove(ctx, views.EnvironmentMoveInput{
+				EnvironmentID: input.EnvironmentID,
+				ResourceIDs:   ids,
+			}, "Confirm")
+		}),
+	)
+}
+
+func init() {
+	environmentMoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.EnvironmentMoveInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.MoveResources(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForMoveResources(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		if len(input.ResourceIDs) == 0 {
+			InteractiveEnvironmentMovePicker(cmd.Context(), input, "Move to "+input.EnvironmentID)
+			return nil
+		}
+		InteractiveEnvironmentMove(cmd.Context(), input, "Move to "+input.EnvironmentID)
+		return nil
+	}
+
+	environmentMoveCmd.Flags().StringSlice("resources", nil, "Comma separated list of service, Postgres or Key Value IDs to move")
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/environment"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var environmentUpdateCmd = &cobra.Command{
+	Use:   "update [environmentID]",
+	Short: "Rename an environment or change its protected status",
+	Long: `Rename an environment or change its protected status. Only the values that are set are changed.
+Only admins can perform destructive actions in protected environments.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveEnvironmentUpdate = func(ctx context.Context, input *views.EnvironmentUpdateInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, environmentUpdateCmd, breadcrumb, input, views.NewEnvironmentUpdateView(ctx, input, environmentUpdateCmd, func(env *client.Environment) tea.Cmd {
+		return command.ShowResultFunc(ctx, environmentUpdateCmd, "Updated", input)(environmentUpdatedMessage(env))
+	}))
+}
+
+func init() {
+	protectedStatusFlag := command.NewEnumInput(environment.ProtectedStatuses, false)
+
+	environmentUpdateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.EnvironmentUpdateInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.Environment, error) {
+			return views.UpdateEnvironment(cmd.Context(), input)
+		}, func(env *client.Environment) string {
+			return text.FormatString(environmentUpdatedMessage(env))
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveEnvironmentUpdate(cmd.Context(), &input, "Update "+input.EnvironmentID)
+		return nil
+	}
+
+	environmentUpdateCmd.Flags().String("name", "", "The new name of the environment")
+	environmentUpdateCmd.Flags().Var(protectedStatusFlag, "protected-status", "The new protected status of the environment")
+}
+
+func environmentUpdatedMessage(env *client.Environment) string {
+	return fmt.Sprintf("Updated environment %s (%s), %s", env.Name, env.Id, env.ProtectedStatus)
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var headerCmd = &cobra.Command{
+	Use:   "headers",
+	Short: "Manage response header rules for static sites",
+	Long: `Manage response header rules for static sites.
+Paths can use wildcards, e.g. /* or /assets/*, to apply a header to every matching path. Use apply to replace every
+rule at once from a version-controlled file, including Netlify _headers files.`,
+	GroupID: GroupCore.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(headerCmd)
+	headerCmd.AddCommand(headerListCmd, headerAddCmd, headerRemoveCmd, headerApplyCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var headerAddCmd = &cobra.Command{
+	Use:   "add [serviceID]",
+	Short: "Add a response header rule to a static site",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveHeaderAdd = func(ctx context.Context, input *views.HeaderAddInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(
+		ctx,
+		headerAddCmd,
+		breadcrumb,
+		input,
+		views.NewHeaderAddView(ctx, input, headerAddCmd, func(h *client.Header) tea.Cmd {
+			return InteractiveHeaderList(ctx, views.HeaderListInput{ServiceID: input.ServiceID}, "Headers")
+		}),
+	)
+}
+
+func init() {
+	headerAddCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.HeaderAddInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.Header, error) {
+			return views.AddHeader(cmd.Context(), input)
+		}, func(h *client.Header) string {
+			return text.FormatStringF("Added header %s: %s to %s", h.Name, h.Value, h.Path)
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveHeaderAdd(cmd.Context(), &input, "Add header")
+		return nil
+	}
+
+	headerAddCmd.Flags().String("path", "/*", "The path to add the header to. Wildcards apply the header to all matching paths")
+	headerAddCmd.Flags().String("name", "", "The header name, e.g. Strict-Transport-Security")
+	headerAddCmd.Flags().String("value", "", "The header value")
+}
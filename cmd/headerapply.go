@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/rulefile"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var headerApplyCmd = &cobra.Command{
+	Use:   "apply [serviceID]",
+	Short: "Replace all response header rules of a static site with rules from a file",
+	Long: `Replace all response header rules of a static site with the rules in a file.
+The changes are shown before they are applied. Use --dry-run to only show the changes, e.g. to review them in CI
+before applying with --confirm.
+
+Supported formats:
+  netlify  A Netlify _headers file. Paths start at the beginning of a line and are followed by indented
+           "Name: Value" lines. Repeated headers for a path are combined into one comma separated value.
+  yaml     A list of rules with path, name and value keys, either at the top level or under a headers key,
+           matching the headers section of render.yaml.
+  json     The same list as JSON. The output of "render headers list -o json" can be applied directly.
+
+The format is detected from the file extension when --format is not set. Files without a .yaml, .yml or .json
+extension are read as Netlify _headers files.
+
+A file without any rules is rejected, since applying it would remove every rule from the service.
+Set --allow-empty to do that on purpose.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveHeaderApply = func(ctx context.Context, input views.HeaderApplyInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, headerApplyCmd, breadcrumb, &input, views.NewHeaderApplyView(ctx, input))
+}
+
+func init() {
+	formatFlag := command.NewEnumInput(rulefile.Formats, false)
+
+	headerApplyCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.HeaderApplyInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		var confirm command.ConfirmFunc
+		if !input.DryRun {
+			confirm = func() (string, error) { return views.RequireConfirmationForApplyHeaders(cmd.Context(), input) }
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (*header.ApplyResult, error) { return views.ApplyHeaders(cmd.Context(), input) },
+			text.HeaderApply(input.ServiceID),
+			confirm,
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveHeaderApply(cmd.Context(), input, "Apply headers")
+		return nil
+	}
+
+	headerApplyCmd.Flags().String("file", "", "Path to the file containing the rules")
+	headerApplyCmd.Flags().Var(formatFlag, "format", "The format of the file. Detected from the file name if not set")
+	headerApplyCmd.Flags().Bool("allow-empty", false, "Apply a file without any rules, removing all rules from the service")
+	headerApplyCmd.Flags().Bool("dry-run", false, "Show the changes without applying them")
+	if err := headerApplyCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+}
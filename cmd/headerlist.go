@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var headerListCmd = &cobra.Command{
+	Use:   "list [serviceID]",
+	Short: "List response header rules for a static site",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveHeaderList = func(ctx context.Context, input views.HeaderListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, headerListCmd, breadcrumb, &input, views.NewHeaderList(ctx, input,
+		func(ctx context.Context, h *client.Header) tea.Cmd {
+			return InteractivePalette(ctx, commandsForHeader(input.ServiceID, h), h.Name)
+		},
+		tui.WithCustomOptions[*client.Header]([]tui.CustomOption{
+			WithCopyID(ctx, headerCmd),
+		}),
+	))
+}
+
+func commandsForHeader(serviceID string, h *client.Header) []views.PaletteCommand {
+	return []views.PaletteCommand{
+		{
+			Name:        "remove",
+			Description: "Remove the header rule",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveHeaderRemove(ctx, views.HeaderRemoveInput{ServiceID: serviceID, HeaderID: h.Id}, "Remove header")
+			},
+		},
+	}
+}
+
+func init() {
+	headerListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.HeaderListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*client.Header, error) {
+			return views.LoadHeaders(cmd.Context(), input)
+		}, text.HeaderTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveHeaderList(cmd.Context(), input, "Headers")
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var headerRemoveCmd = &cobra.Command{
+	Use:   "remove [serviceID] [headerID]",
+	Short: "Remove a response header rule from a static site",
+	Args:  cobra.ExactArgs(2),
+}
+
+var InteractiveHeaderRemove = func(ctx context.Context, input views.HeaderRemoveInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, headerRemoveCmd, breadcrumb, &input, views.NewHeaderRemoveView(ctx, input))
+}
+
+func init() {
+	headerRemoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.HeaderRemoveInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.RemoveHeader(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForRemoveHeader(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveHeaderRemove(cmd.Context(), input, "Remove header")
+		return nil
+	}
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -10,6 +12,7 @@ import (
 	"github.com/renderinc/cli/pkg/client"
 	clientjob "github.com/renderinc/cli/pkg/client/jobs"
 	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/job"
 	"github.com/renderinc/cli/pkg/resource"
 	"github.com/renderinc/cli/pkg/text"
 	"github.com/renderinc/cli/pkg/tui/views"
@@ -72,13 +75,33 @@ func init() {
 			return fmt.Errorf("failed to parse command: %w", err)
 		}
 
+		wait, err := cmd.Flags().GetBool("wait")
+		if err != nil {
+			return err
+		}
+
+		// if wait flag is used, default to non-interactive output
+		if wait {
+			command.DefaultFormatNonInteractive(cmd)
+		}
+
+		var j *clientjob.Job
 		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientjob.Job, error) {
-			return views.CreateJob(cmd.Context(), input)
-		}, func(j *clientjob.Job) string {
-			return text.FormatStringF("Created job %s for %s", j.Id, input.ServiceID)
-		}); err != nil {
+			since := time.Now().Add(-time.Minute)
+
+			j, err = views.CreateJob(cmd.Context(), input)
+			if err != nil || !wait {
+				return j, err
+			}
+
+			j, err = waitForJob(cmd, views.JobWaitInput{ServiceID: input.ServiceID, JobID: j.Id}, since)
+			return j, err
+		}, text.Job(input.ServiceID)); err != nil {
 			return err
 		} else if nonInteractive {
+			if wait && !job.IsSuccessful(j.Status) {
+				os.Exit(1)
+			}
 			return nil
 		}
 
@@ -88,4 +111,5 @@ func init() {
 
 	JobCreateCmd.Flags().String("start-command", "", "The command to run for the job")
 	JobCreateCmd.Flags().String("plan-id", "", "The plan ID for the job (optional)")
+	JobCreateCmd.Flags().Bool("wait", false, "Tail logs and wait for the job to finish. Returns non-zero exit code if the job fails")
 }
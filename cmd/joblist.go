@@ -13,6 +13,7 @@ import (
 	"github.com/renderinc/cli/pkg/job"
 	"github.com/renderinc/cli/pkg/resource"
 	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
 	"github.com/renderinc/cli/pkg/tui/views"
 )
 
@@ -28,9 +29,24 @@ var InteractiveJobList = func(ctx context.Context, input views.JobListInput, bre
 		func(j *clientjob.Job) tea.Cmd {
 			return InteractivePalette(ctx, commandsForJob(j), j.Id)
 		},
+		tui.WithKeyActions[*clientjob.Job]([]tui.ListKeyAction{
+			{
+				Key:   "r",
+				Title: "rerun",
+				Function: func(item tui.ListItem) tea.Cmd {
+					return interactiveJobRerun(ctx, item.(job.ListItem).Job())
+				},
+			},
+		}),
 	))
 }
 
+// interactiveJobRerun opens the create form pre-filled with the job's command and plan so they can be edited
+func interactiveJobRerun(ctx context.Context, j *clientjob.Job) tea.Cmd {
+	input := views.JobRerunInput{ServiceID: j.ServiceId, JobID: j.Id}.CreateInput(j)
+	return InteractiveJobCreate(ctx, &input, "Rerun")
+}
+
 func interactiveJobList(cmd *cobra.Command, input views.JobListInput) tea.Cmd {
 	ctx := cmd.Context()
 	if input.ServiceID == "" {
@@ -89,13 +105,7 @@ func commandsForJob(j *clientjob.Job) []views.PaletteCommand {
 			Name:        "rerun",
 			Description: "Create new job with same inputs",
 			Action: func(ctx context.Context, args []string) tea.Cmd {
-				return InteractiveJobCreate(ctx, &views.JobCreateInput{
-					ServiceID:    j.ServiceId,
-					StartCommand: &j.StartCommand,
-					PlanID:       &j.PlanId,
-				},
-					"Rerun",
-				)
+				return interactiveJobRerun(ctx, j)
 			},
 		},
 	}
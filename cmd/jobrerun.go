@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	clientjob "github.com/renderinc/cli/pkg/client/jobs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var jobRerunCmd = &cobra.Command{
+	Use:   "rerun [serviceID] [jobID]",
+	Short: "Create a new job with the same command and plan as a previous job",
+	Long: `Create a new job with the same command and plan as a previous job.
+Set --start-command or --plan-id to change them. In interactive mode you can edit them before the job is created.`,
+	Args: cobra.ExactArgs(2),
+}
+
+func init() {
+	jobRerunCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.JobRerunInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientjob.Job, error) {
+			return views.RerunJob(cmd.Context(), input)
+		}, text.Job(input.ServiceID)); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		createInput, err := views.LoadJobRerunInput(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		InteractiveJobCreate(cmd.Context(), &createInput, "Rerun "+input.JobID)
+		return nil
+	}
+
+	jobRerunCmd.Flags().String("start-command", "", "The command to run. Defaults to the previous job's command")
+	jobRerunCmd.Flags().String("plan-id", "", "The plan ID for the job. Defaults to the previous job's plan")
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	clientjob "github.com/renderinc/cli/pkg/client/jobs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/job"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var jobWaitCmd = &cobra.Command{
+	Use:   "wait [serviceID] [jobID]",
+	Short: "Wait for a job to finish and tail its logs",
+	Long: `Wait for a job to finish. Logs since the job started are streamed to stderr while waiting.
+Returns a non-zero exit code if the job fails or is canceled.`,
+	Args: cobra.ExactArgs(2),
+}
+
+func init() {
+	jobWaitCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.JobWaitInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		// there is nothing to interact with while waiting
+		command.DefaultFormatNonInteractive(cmd)
+
+		var j *clientjob.Job
+		if _, err := command.NonInteractive(cmd, func() (*clientjob.Job, error) {
+			j, err = views.LoadJob(cmd.Context(), input)
+			if err != nil {
+				return nil, err
+			}
+			j, err = waitForJob(cmd, input, job.LogsStartTime(j))
+			return j, err
+		}, text.Job(input.ServiceID)); err != nil {
+			return err
+		}
+
+		if !job.IsSuccessful(j.Status) {
+			os.Exit(1)
+		}
+		return nil
+	}
+}
+
+// waitForJob streams the job's logs since the given time to stderr until the job reaches a terminal status
+func waitForJob(cmd *cobra.Command, input views.JobWaitInput, since time.Time) (*clientjob.Job, error) {
+	_, err := fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for job %s to complete...\n\n", input.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	stopTail, err := tailLogsToStderr(cmd, input.JobID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer stopTail()
+
+	return views.WaitForJob(cmd.Context(), input)
+}
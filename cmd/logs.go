@@ -5,15 +5,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
 	"github.com/renderinc/cli/pkg/client"
 	lclient "github.com/renderinc/cli/pkg/client/logs"
 	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logs"
 	"github.com/renderinc/cli/pkg/pointers"
 	"github.com/renderinc/cli/pkg/resource"
 	"github.com/renderinc/cli/pkg/tui"
@@ -26,9 +33,28 @@ var LogsCmd = &cobra.Command{
 	Long: `View logs for services and datastores.
 
 Use flags to filter logs by resource, instance, time, text, level, type, host, status code, method, or path.
-Unlike in the dashboard, you can view logs for multiple resources at once. Set --tail=true to stream new logs (currently only in interactive mode).
+Unlike in the dashboard, you can view logs for multiple resources at once. Set --tail=true to stream new logs.
+Use --all or a --limit over 100 to page through larger time ranges.
+With --output json, streamed logs are written as one JSON object per line.
 
-In interactive mode you can update the filters and view logs in real time.`,
+Use --out to export logs to a file for later review. Exported logs include every label, such as level, instance,
+type, host and status code, as fields in NDJSON or columns in CSV.
+
+Logs with JSON messages can be processed locally. Use --fields to show only some fields, with dots for nested
+fields, --where to keep logs matching conditions like 'status>=500', 'level=error' or 'msg=~timeout', and
+--expand-json to pretty print them. Logs are counted towards --limit before --where filters them.
+
+Text output colors log levels and status codes, shows method, path, status code and response time for request
+logs, and prefixes each log with its instance when querying several resources. Use --format to write each log with
+a Go template instead. Templates can use .Timestamp, .ID, .Message, .Resource, .Instance, .Level, .Type, .Host,
+.Method, .Path and .StatusCode, and {{.Label "name"}} for other labels.
+
+Use --save to save a query as a named preset and --preset to run it again. Flags passed with --preset replace
+the preset's values, so a preset can be changed by running it with new flags and --save under the same name.
+Presets are stored in the CLI config, see logs presets list.
+
+In interactive mode you can update the filters and view logs in real time. Scroll to the top to load older logs,
+press ctrl+f to search the loaded logs and p to pause following new logs while tailing.`,
 	GroupID: GroupCore.ID,
 }
 
@@ -36,7 +62,7 @@ func filterLogs(ctx context.Context, in views.LogInput, breadcrumb string) tea.C
 	return command.AddToStackFunc(ctx, LogsCmd, breadcrumb, &in, views.NewLogsView(ctx, LogsCmd, filterLogs, in, views.LoadLogData))
 }
 
-func writeLog(format command.Output, out io.Writer, log *lclient.Log) error {
+func writeLog(format command.Output, text *logs.Formatter, out io.Writer, log *lclient.Log) error {
 	var str []byte
 	var err error
 	if format == command.JSON {
@@ -44,7 +70,9 @@ func writeLog(format command.Output, out io.Writer, log *lclient.Log) error {
 	} else if format == command.YAML {
 		str, err = yaml.Marshal(log)
 	} else if format == command.TEXT {
-		str = []byte(fmt.Sprintf("%s  %s\n", log.Timestamp.Format(time.DateTime), log.Message))
+		var line string
+		line, err = text.Format(log)
+		str = []byte(line + "\n")
 	}
 
 	if err != nil {
@@ -55,35 +83,255 @@ func writeLog(format command.Output, out io.Writer, log *lclient.Log) error {
 	return err
 }
 
+// writeLogLine writes JSON logs as one object per line, so streamed logs can be piped to tools like jq as they
+// arrive. Other formats are written as usual.
+func writeLogLine(format command.Output, text *logs.Formatter, out io.Writer, log *lclient.Log) error {
+	if format != command.JSON {
+		return writeLog(format, text, out, log)
+	}
+
+	str, err := json.Marshal(log)
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(append(str, '\n'))
+	return err
+}
+
+// textLogFormatter returns the formatter for text output from the --format flag. Logs are prefixed with their source
+// when several resources are queried.
+func textLogFormatter(cmd *cobra.Command, input views.LogInput, renderer *lipgloss.Renderer) (*logs.Formatter, error) {
+	var opts []logs.FormatOption
+	if len(input.ResourceIDs) > 1 {
+		opts = append(opts, logs.WithSource())
+	}
+
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return nil, err
+	}
+	if format != "" {
+		tmpl, err := logs.ParseFormat(format)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, logs.WithTemplate(tmpl))
+	}
+
+	return logs.NewFormatter(renderer, opts...), nil
+}
+
 func nonInteractiveLogs(format *command.Output, cmd *cobra.Command, input views.LogInput) error {
-	result, err := views.LoadLogData(cmd.Context(), input)
+	text, err := textLogFormatter(cmd, input, lipgloss.NewRenderer(cmd.OutOrStdout()))
 	if err != nil {
 		return err
 	}
 
-	if result.Logs != nil {
-		for _, log := range result.Logs.Logs {
-			if err := writeLog(*format, cmd.OutOrStdout(), &log); err != nil {
-				return err
-			}
+	write := writeLog
+	if input.Tail {
+		write = writeLogLine
+	}
+
+	return forEachLog(cmd, input, func(log *lclient.Log) error {
+		return write(*format, text, cmd.OutOrStdout(), log)
+	})
+}
+
+// forEachLog calls fn with each log matching input, streaming new logs if input.Tail is set. On Ctrl-C it stops
+// cleanly so the logs received so far are written.
+func forEachLog(cmd *cobra.Command, input views.LogInput, fn func(log *lclient.Log) error) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if !input.Tail {
+		err := forEachLogPage(ctx, cmd, input, fn)
+		if ctx.Err() != nil {
+			return nil
 		}
+		return err
+	}
+
+	result, err := views.LoadLogData(ctx, input)
+	if err != nil {
+		return err
 	}
 
 	if result.LogChannel != nil {
-		for {
-			log, ok := <-result.LogChannel
-			if !ok {
-				break
+		for evt := range result.LogChannel {
+			switch evt := evt.(type) {
+			case logs.LogReceived:
+				if err := fn(evt.Log); err != nil {
+					return err
+				}
+			case logs.Disconnected:
+				// connection changes go to stderr so they don't mix with the logs
+				fmt.Fprintf(cmd.ErrOrStderr(), "Log stream disconnected: %v. Reconnecting in %s\n", evt.Err, evt.RetryIn)
+			case logs.Reconnected:
+				fmt.Fprintf(cmd.ErrOrStderr(), "Log stream reconnected, %d missed logs loaded\n", evt.Backfilled)
+			case logs.TailFailed:
+				return evt.Err
 			}
-			if err := writeLog(*format, cmd.OutOrStdout(), log); err != nil {
+		}
+	}
+
+	return nil
+}
+
+// forEachLogPage calls fn with logs as each page is fetched, so large queries don't have to be held in memory, and
+// reports progress on stderr when it's a terminal
+func forEachLogPage(ctx context.Context, cmd *cobra.Command, input views.LogInput, fn func(log *lclient.Log) error) error {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return err
+	}
+
+	params, err := input.ToParam()
+	if err != nil {
+		return fmt.Errorf("error converting input to params: %v", err)
+	}
+
+	pipeline, err := input.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	limit := params.Limit
+	if input.All {
+		limit = pointers.From(0)
+	}
+
+	stderr := cmd.ErrOrStderr()
+	showProgress := false
+	if f, ok := stderr.(*os.File); ok {
+		showProgress = isatty.IsTerminal(f.Fd())
+	}
+
+	var count int
+	err = logs.NewLogRepo(c).ListLogPages(ctx, params, *limit, func(page *client.Logs200Response) error {
+		for _, log := range pipeline.Apply(page.Logs) {
+			if err := fn(&log); err != nil {
 				return err
 			}
 		}
+
+		count += len(page.Logs)
+		if showProgress {
+			fmt.Fprintf(stderr, "\rFetched %d logs", count)
+		}
+		return nil
+	})
+	if showProgress && count > 0 {
+		fmt.Fprintln(stderr)
+	}
+	if err != nil {
+		return fmt.Errorf("error listing logs: %v", err)
+	}
+	return nil
+}
+
+// logFlushDelay is how long to keep tailing logs after a run ends, since logs can arrive after the end event
+const logFlushDelay = 3 * time.Second
+
+// tailLogsToStderr streams the resource's logs as text so stdout only contains the command's result. The returned
+// function stops tailing after giving late logs a chance to arrive.
+func tailLogsToStderr(cmd *cobra.Command, resourceID string, since time.Time) (func(), error) {
+	ctx, cancel := context.WithCancel(cmd.Context())
+
+	result, err := views.LoadLogData(ctx, views.LogInput{
+		ResourceIDs: []string{resourceID},
+		StartTime:   &command.TimeOrRelative{T: pointers.From(since)},
+		Tail:        true,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	text := logs.NewFormatter(lipgloss.NewRenderer(cmd.ErrOrStderr()))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-result.LogChannel:
+				if !ok {
+					return
+				}
+				if evt, ok := evt.(logs.LogReceived); ok {
+					if err := writeLog(command.TEXT, text, cmd.ErrOrStderr(), evt.Log); err != nil {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return func() {
+		time.Sleep(logFlushDelay)
+		cancel()
+		<-done
+	}, nil
+}
+
+// filterCompletionTimeout keeps shell completion responsive if the API is slow
+const filterCompletionTimeout = 5 * time.Second
+
+// completeLogFilter completes a filter flag with the values seen in the logs of the resources in --resources
+func completeLogFilter(filter string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		resourceIDs, err := cmd.Flags().GetStringSlice("resources")
+		if err != nil || len(resourceIDs) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), filterCompletionTimeout)
+		defer cancel()
+
+		values, err := views.LoadFilterValues(ctx, views.LogInput{ResourceIDs: resourceIDs}, filter)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return command.ListSuggestion(toComplete, values), cobra.ShellCompDirectiveNoFileComp
 	}
+}
 
+// applyLogPreset sets the flags saved in the --preset preset, except flags that were passed
+func applyLogPreset(cmd *cobra.Command) error {
+	name, err := cmd.Flags().GetString("preset")
+	if err != nil || name == "" {
+		return err
+	}
+
+	preset, err := logs.GetPreset(name)
+	if err != nil {
+		return err
+	}
+
+	if err := command.SetFlagsFromArgs(cmd.Flags(), preset.Args); err != nil {
+		return fmt.Errorf("invalid log preset %s: %w", name, err)
+	}
 	return nil
 }
 
+func completeLogPreset(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	presets, err := logs.ListPresets()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var names []string
+	for _, p := range presets {
+		if strings.HasPrefix(p.Name, toComplete) {
+			names = append(names, p.Name)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
 func TailResourceLogs(ctx context.Context, resourceID string) tea.Cmd {
 	return InteractiveLogs(
 		ctx,
@@ -129,6 +377,7 @@ func init() {
 		"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "HEAD", "CONNECT", "TRACE",
 	}, true)
 
+	splitByFlag := command.NewEnumInput(logs.SplitByValues, false)
 	startTimeFlag := command.NewTimeInput()
 	endTimeFlag := command.NewTimeInput()
 
@@ -139,6 +388,32 @@ func init() {
 			return err
 		}
 
+		save, err := cmd.Flags().GetString("save")
+		if err != nil {
+			return err
+		}
+		if save != "" {
+			if err := views.SaveLogPreset(save, input); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Saved log preset %s\n", save)
+		}
+
+		out, err := cmd.Flags().GetString("out")
+		if err != nil {
+			return err
+		}
+		if out != "" {
+			return exportLogs(cmd, input, out, splitByFlag.String())
+		}
+		if splitByFlag.String() != "" {
+			return fmt.Errorf("--split-by can only be used with --out")
+		}
+
+		if cmd.Flags().Changed("format") {
+			command.DefaultFormatNonInteractive(cmd)
+		}
+
 		format := command.GetFormatFromContext(cmd.Context())
 		if format != nil && (*format != command.Interactive) {
 			return nonInteractiveLogs(format, cmd, input)
@@ -149,9 +424,14 @@ func init() {
 	}
 
 	LogsCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		// apply the preset first, so its resources count towards the required flag
+		if err := applyLogPreset(cmd); err != nil {
+			return err
+		}
+
 		// Resources flag is required in non-interactive mode
 		format := command.GetFormatFromContext(cmd.Context())
-		if format != nil && *format != command.Interactive {
+		if (format != nil && *format != command.Interactive) || cmd.Flags().Changed("out") || cmd.Flags().Changed("format") {
 			return LogsCmd.MarkFlagRequired("resources")
 		}
 		return nil
@@ -170,7 +450,27 @@ func init() {
 	LogsCmd.Flags().StringSlice("status-code", []string{}, "A list of comma separated status codes to query")
 	LogsCmd.Flags().Var(methodTypeFlag, "method", "A list of comma separated HTTP methods to query")
 	LogsCmd.Flags().StringSlice("path", []string{}, "A list of comma separated paths to query")
-	LogsCmd.Flags().Int("limit", 100, "The maximum number of logs to return")
+	LogsCmd.Flags().Int("limit", 100, "The maximum number of logs to return. Limits over 100 are fetched in pages")
+	LogsCmd.Flags().Bool("all", false, "Return all logs that match the query, fetching as many pages as needed")
 	LogsCmd.Flags().Var(directionFlag, "direction", "The direction to query the logs. Can be 'forward' or 'backward'")
 	LogsCmd.Flags().Bool("tail", false, "Stream new logs")
+	LogsCmd.Flags().StringSlice("fields", []string{}, "A list of comma separated fields to show from JSON log messages, e.g. msg,user_id,trace_id")
+	LogsCmd.Flags().StringArray("where", []string{}, "Only show logs whose JSON fields or labels match a condition, e.g. 'status>=500'. Repeat for several conditions")
+	LogsCmd.Flags().Bool("expand-json", false, "Pretty print JSON log messages")
+	LogsCmd.Flags().String("out", "", "Export logs to a file instead of displaying them. The format is chosen from the extension: .ndjson, .csv or .txt, optionally followed by .gz")
+	LogsCmd.Flags().Var(splitByFlag, "split-by", "With --out, write a separate file per resource or instance")
+
+	LogsCmd.Flags().String("format", "", "A Go template to format each log in text output, e.g. '{{.Instance}} {{.Message}}'")
+
+	LogsCmd.Flags().String("preset", "", "Run a saved query. Other flags replace the preset's values")
+	LogsCmd.Flags().String("save", "", "Save the query as a preset with this name, replacing any preset with the same name")
+	if err := LogsCmd.RegisterFlagCompletionFunc("preset", completeLogPreset); err != nil {
+		panic(err)
+	}
+
+	for _, filter := range logs.SuggestedFilters {
+		if err := LogsCmd.RegisterFlagCompletionFunc(filter, completeLogFilter(filter)); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logs"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+// exportFile is one file logs are exported to
+type exportFile struct {
+	path  string
+	file  *os.File
+	gzip  *gzip.Writer
+	csv   *csv.Writer
+	out   io.Writer
+	count int
+}
+
+func (f *exportFile) close() error {
+	if f.csv != nil {
+		f.csv.Flush()
+		if err := f.csv.Error(); err != nil {
+			f.file.Close()
+			return err
+		}
+	}
+	if f.gzip != nil {
+		if err := f.gzip.Close(); err != nil {
+			f.file.Close()
+			return err
+		}
+	}
+	return f.file.Close()
+}
+
+// logExporter writes logs to the file at path in the format matching its extension, or to one file per label value
+// if splitBy is set
+type logExporter struct {
+	path    string
+	format  logs.ExportFormat
+	gzip    bool
+	splitBy lclient.LogLabelName
+	text    *logs.Formatter
+
+	files map[string]*exportFile
+	order []string
+}
+
+func newLogExporter(path, splitBy string, text *logs.Formatter) (*logExporter, error) {
+	format, gz, err := logs.ParseExportPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logExporter{
+		path:    path,
+		format:  format,
+		gzip:    gz,
+		splitBy: lclient.LogLabelName(splitBy),
+		text:    text,
+		files:   map[string]*exportFile{},
+	}, nil
+}
+
+func (e *logExporter) Write(log *lclient.Log) error {
+	path := e.path
+	if e.splitBy != "" {
+		path = logs.SplitPath(e.path, logs.LabelValue(log, e.splitBy))
+	}
+
+	f, err := e.file(path)
+	if err != nil {
+		return err
+	}
+	f.count++
+
+	switch e.format {
+	case logs.ExportCSV:
+		return f.csv.Write(logs.CSVRecord(log))
+	case logs.ExportText:
+		return writeLog(command.TEXT, e.text, f.out, log)
+	default:
+		return writeLogLine(command.JSON, e.text, f.out, log)
+	}
+}
+
+func (e *logExporter) file(path string) (*exportFile, error) {
+	if f, ok := e.files[path]; ok {
+		return f, nil
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("error creating export file: %w", err)
+	}
+
+	f := &exportFile{path: path, file: file, out: file}
+	if e.gzip {
+		f.gzip = gzip.NewWriter(file)
+		f.out = f.gzip
+	}
+	if e.format == logs.ExportCSV {
+		f.csv = csv.NewWriter(f.out)
+		if err := f.csv.Write(logs.CSVHeader()); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	e.files[path] = f
+	e.order = append(e.order, path)
+	return f, nil
+}
+
+// Close flushes and closes every file written to
+func (e *logExporter) Close() error {
+	var errs []error
+	for _, path := range e.order {
+		errs = append(errs, e.files[path].close())
+	}
+	return errors.Join(errs...)
+}
+
+// exportLogs writes the logs matching input to files instead of stdout, and reports the files written on stderr
+func exportLogs(cmd *cobra.Command, input views.LogInput, out, splitBy string) error {
+	// a renderer that isn't writing to a terminal keeps colors out of the files
+	text, err := textLogFormatter(cmd, input, lipgloss.NewRenderer(io.Discard))
+	if err != nil {
+		return err
+	}
+
+	exporter, err := newLogExporter(out, splitBy, text)
+	if err != nil {
+		return err
+	}
+
+	err = forEachLog(cmd, input, exporter.Write)
+	if closeErr := exporter.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(exporter.order) == 0 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "No logs matched, nothing was exported")
+		return nil
+	}
+	for _, path := range exporter.order {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d logs to %s\n", exporter.files[path].count, path)
+	}
+	return nil
+}
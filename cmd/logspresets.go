@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logs"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logPresetsCmd = &cobra.Command{
+	Use:   "presets",
+	Short: "Manage saved logs queries",
+	Long: `Manage logs queries saved with render logs --save. Run a preset with render logs --preset NAME, and change it
+by running it with new flags and --save under the same name.`,
+}
+
+var logPresetListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved logs queries",
+	Args:  cobra.NoArgs,
+}
+
+var logPresetRemoveCmd = &cobra.Command{
+	Use:               "rm [name]",
+	Short:             "Remove a saved logs query",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeLogPreset,
+}
+
+var InteractiveLogPresetList = func(ctx context.Context, input views.LogPresetListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logPresetListCmd, breadcrumb, &input, views.NewLogPresetList(ctx, input,
+		func(ctx context.Context, p logs.Preset) tea.Cmd {
+			return InteractivePalette(ctx, commandsForLogPreset(p), p.Name)
+		},
+	))
+}
+
+var InteractiveLogPresetRemove = func(ctx context.Context, input views.LogPresetRemoveInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logPresetRemoveCmd, breadcrumb, &input, views.NewLogPresetRemoveView(ctx, input))
+}
+
+func commandsForLogPreset(p logs.Preset) []views.PaletteCommand {
+	return []views.PaletteCommand{
+		{
+			Name:        "logs",
+			Description: "View logs with this preset",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				input, err := views.LogInputFromPreset(p.Name)
+				if err != nil {
+					return command.AddErrToStack(ctx, LogsCmd, err)
+				}
+				return InteractiveLogs(ctx, input, "Logs")
+			},
+		},
+		{
+			Name:        "remove",
+			Description: "Remove this preset",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveLogPresetRemove(ctx, views.LogPresetRemoveInput{Name: p.Name}, "Remove "+p.Name)
+			},
+		},
+	}
+}
+
+func init() {
+	LogsCmd.AddCommand(logPresetsCmd)
+	logPresetsCmd.AddCommand(logPresetListCmd, logPresetRemoveCmd)
+
+	logPresetListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogPresetListInput
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]logs.Preset, error) {
+			return views.LoadLogPresets(cmd.Context(), input)
+		}, text.LogPresetTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogPresetList(cmd.Context(), input, "Log Presets")
+		return nil
+	}
+
+	logPresetRemoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogPresetRemoveInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.RemoveLogPreset(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForRemoveLogPreset(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogPresetRemove(cmd.Context(), input, "Remove "+input.Name)
+		return nil
+	}
+}
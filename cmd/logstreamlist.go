@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logStreamListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List where the logs of every resource go",
+	Long: `List where the logs of every resource in the active workspace go, including preview services.
+Settings that come from a resource override rather than the workspace are marked with (override). For example, to
+audit which resources don't send their logs to the workspace log stream:
+
+  render log-streams list --overrides-only -o text`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveLogStreamList = func(ctx context.Context, input views.LogStreamListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logStreamListCmd, breadcrumb, &input, views.NewLogStreamList(ctx, input,
+		func(ctx context.Context, s *logstream.ResourceLogStream) tea.Cmd {
+			return InteractivePalette(ctx, commandsForResourceLogStream(s), logstream.ResourceLabel(s))
+		},
+		tui.WithCustomOptions[*logstream.ResourceLogStream]([]tui.CustomOption{
+			WithCopyID(ctx, logStreamCmd),
+			WithWorkspaceSelection(ctx),
+		}),
+	))
+}
+
+func commandsForResourceLogStream(s *logstream.ResourceLogStream) []views.PaletteCommand {
+	commands := []views.PaletteCommand{
+		{
+			Name:        "drop",
+			Description: "Drop this resource's logs",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveLogStreamOverride(ctx, views.LogStreamOverrideInput{
+					ResourceIDs: []string{s.ResourceID},
+					Drop:        true,
+				}, "Drop")
+			},
+		},
+	}
+
+	if s.IsOverride() {
+		commands = append(commands, views.PaletteCommand{
+			Name:        "default",
+			Description: "Send this resource's logs to the workspace log stream",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveLogStreamOverride(ctx, views.LogStreamOverrideInput{
+					ResourceIDs: []string{s.ResourceID},
+					Default:     true,
+				}, "Default")
+			},
+		})
+	}
+
+	if s.Endpoint != "" {
+		commands = append(commands, views.PaletteCommand{
+			Name:        "test",
+			Description: "Check that this resource's endpoint is reachable",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveLogStreamTest(ctx, views.LogStreamTestInput{Endpoint: s.Endpoint}, "Test")
+			},
+		})
+	}
+	return commands
+}
+
+func init() {
+	settingFlag := command.NewEnumInput(logstream.SettingValues, false)
+
+	logStreamListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogStreamListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*logstream.ResourceLogStream, error) {
+			return views.LoadResourceLogStreams(cmd.Context(), input)
+		}, text.LogStreamTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogStreamList(cmd.Context(), input, "Resource Log Streams")
+		return nil
+	}
+
+	logStreamListCmd.Flags().StringSliceP("environment-ids", "e", nil, "Comma separated list of environment ids to filter by")
+	logStreamListCmd.Flags().Var(settingFlag, "setting", "Only list resources that send or drop their logs")
+	logStreamListCmd.Flags().Bool("overrides-only", false, "Only list resources that override the workspace log stream")
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logStreamOverrideCmd = &cobra.Command{
+	Use:   "override [resourceID...]",
+	Short: "Override the workspace log stream for one or more resources",
+	Long: `Override the workspace log stream for one or more resources, either dropping their logs or sending them to
+another endpoint. Use --default to go back to the workspace log stream. If the endpoint requires a token, it is
+read from --token-file, or from stdin with --token-file -. For example:
+
+  render log-streams override srv-123 red-456 --drop
+  echo "$LOG_TOKEN" | render log-streams override srv-123 --endpoint logs.example.com:6514 --token-file -`,
+	Args: cobra.MinimumNArgs(1),
+}
+
+var InteractiveLogStreamOverride = func(ctx context.Context, input views.LogStreamOverrideInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logStreamOverrideCmd, breadcrumb, &input, views.NewLogStreamOverrideView(ctx, input))
+}
+
+func init() {
+	logStreamOverrideCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogStreamOverrideInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+		input.ResourceIDs = args
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*lclient.ResourceLogStreamSetting, error) {
+			return views.OverrideResourceLogStreams(cmd.Context(), input)
+		}, func(overrides []*lclient.ResourceLogStreamSetting) string {
+			return text.FormatString(views.LogStreamOverrideMessage(overrides))
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogStreamOverride(cmd.Context(), input, "Override")
+		return nil
+	}
+
+	logStreamOverrideCmd.Flags().Bool("drop", false, "Drop the resources' logs instead of sending them to the workspace log stream")
+	logStreamOverrideCmd.Flags().String("endpoint", "", "Send the resources' logs to this syslog endpoint instead, as host:port")
+	logStreamOverrideCmd.Flags().String("token-file", "", "Path of a file containing the token to authenticate with --endpoint, or - to read it from stdin")
+	logStreamOverrideCmd.Flags().Bool("default", false, "Remove the override so the resources use the workspace log stream")
+	logStreamOverrideCmd.MarkFlagsMutuallyExclusive("drop", "endpoint", "default")
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logStreamRemoveCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Remove the log stream of the active workspace",
+	Long: `Remove the log stream of the active workspace. Resource overrides are kept, use log-streams override --default
+to remove them.`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveLogStreamRemove = func(ctx context.Context, input views.LogStreamRemoveInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logStreamRemoveCmd, breadcrumb, &input, views.NewLogStreamRemoveView(ctx, input))
+}
+
+func init() {
+	logStreamRemoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogStreamRemoveInput
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.RemoveLogStream(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForRemoveLogStream(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogStreamRemove(cmd.Context(), input, "Remove Log Stream")
+		return nil
+	}
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var logStreamCmd = &cobra.Command{
+	Use:   "log-streams",
+	Short: "Manage log streams",
+	Long: `Manage the syslog endpoint that the active workspace streams its logs to, and override it for individual resources.
+Use list to audit which resources send their logs elsewhere or drop them, and test to check that an endpoint is reachable.`,
+	GroupID: GroupManagement.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(logStreamCmd)
+	logStreamCmd.AddCommand(logStreamShowCmd, logStreamSetCmd, logStreamRemoveCmd, logStreamOverrideCmd, logStreamListCmd, logStreamTestCmd)
+}
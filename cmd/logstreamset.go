@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logStreamSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set the log stream of the active workspace",
+	Long: `Set the syslog endpoint that the active workspace streams its logs to. Settings that aren't passed are left unchanged.
+Resources send their logs to this endpoint unless they have an override, see log-streams override. If the endpoint
+requires a token, it is read from --token-file, or from stdin with --token-file -. For example:
+
+  render log-streams set --endpoint logs.example.com:6514 --token-file token.txt --preview drop
+  echo "$LOG_TOKEN" | render log-streams set --token-file -`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveLogStreamSet = func(ctx context.Context, input *views.LogStreamSetInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logStreamSetCmd, breadcrumb, input,
+		views.NewLogStreamSetView(ctx, input, logStreamSetCmd,
+			command.ShowResultFunc(ctx, logStreamSetCmd, "Updated", input)))
+}
+
+func init() {
+	previewFlag := command.NewEnumInput(logstream.PreviewValues, false)
+
+	logStreamSetCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogStreamSetInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*lclient.OwnerLogStreamSetting, error) {
+			return views.SetLogStream(cmd.Context(), input)
+		}, text.LogStreamSettings); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogStreamSet(cmd.Context(), &input, "Set Log Stream")
+		return nil
+	}
+
+	logStreamSetCmd.Flags().String("endpoint", "", "The syslog endpoint to stream logs to, as host:port")
+	logStreamSetCmd.Flags().String("token-file", "", "Path of a file containing the token to authenticate with the endpoint, or - to read it from stdin. Keeps the current token if not set")
+	logStreamSetCmd.Flags().Var(previewFlag, "preview", "Whether preview resources send their logs to the endpoint")
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logStreamShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the log stream of the active workspace",
+	Args:  cobra.NoArgs,
+}
+
+var InteractiveLogStreamShow = func(ctx context.Context, input views.LogStreamSettingsInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logStreamShowCmd, breadcrumb, &input, views.NewLogStreamSettingsView(ctx, input))
+}
+
+func init() {
+	logStreamShowCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogStreamSettingsInput
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*lclient.OwnerLogStreamSetting, error) {
+			return views.LoadLogStreamSettings(cmd.Context(), input)
+		}, text.LogStreamSettings); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogStreamShow(cmd.Context(), input, "Log Stream")
+		return nil
+	}
+}
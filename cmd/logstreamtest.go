@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var logStreamTestCmd = &cobra.Command{
+	Use:   "test [resourceID]",
+	Short: "Check that a log stream endpoint is reachable",
+	Long: `Check that a log stream endpoint is reachable by opening a TCP connection to it from this machine and completing
+a TLS handshake. No logs are sent. Tests the workspace log stream by default, the endpoint a resource sends its logs to
+when given a resource ID, or any endpoint with --endpoint.
+
+Render connects from its own network, so a firewall that only allows Render's IP addresses can make this test fail even
+though streaming works.`,
+	Args: cobra.MaximumNArgs(1),
+}
+
+var InteractiveLogStreamTest = func(ctx context.Context, input views.LogStreamTestInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, logStreamTestCmd, breadcrumb, &input, views.NewLogStreamTestView(ctx, input))
+}
+
+func init() {
+	logStreamTestCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.LogStreamTestInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*logstream.TestResult, error) {
+			return views.TestLogStream(cmd.Context(), input)
+		}, text.LogStreamTest); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveLogStreamTest(cmd.Context(), input, "Test Log Stream")
+		return nil
+	}
+
+	logStreamTestCmd.Flags().String("endpoint", "", "Test this endpoint instead of a configured one, as host:port")
+}
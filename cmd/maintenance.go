@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Manage scheduled maintenance",
+	Long: `View and reschedule maintenance Render has scheduled for services and datastores in the active workspace.
+In interactive mode you can move a maintenance run to a better time or start it right away.`,
+	GroupID: GroupManagement.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(maintenanceCmd)
+	maintenanceCmd.AddCommand(maintenanceListCmd, maintenanceRescheduleCmd, maintenanceTriggerCmd)
+}
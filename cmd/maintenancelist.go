@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientmaintenance "github.com/renderinc/cli/pkg/client/maintenance"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/maintenance"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var maintenanceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List maintenance runs and the resources they affect",
+	Long: `List maintenance runs in the active workspace, ordered by when they are scheduled. The deadline is the latest
+time a run can be rescheduled to.`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveMaintenanceList = func(ctx context.Context, input views.MaintenanceListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, maintenanceListCmd, breadcrumb, &input, views.NewMaintenanceList(ctx, input,
+		func(ctx context.Context, m *maintenance.Model) tea.Cmd {
+			return InteractivePalette(ctx, commandsForMaintenance(m), maintenance.ResourceLabel(m))
+		},
+		tui.WithCustomOptions[*maintenance.Model]([]tui.CustomOption{
+			WithCopyID(ctx, maintenanceCmd),
+			WithWorkspaceSelection(ctx),
+		}),
+	))
+}
+
+func commandsForMaintenance(m *maintenance.Model) []views.PaletteCommand {
+	if m.Run.State != clientmaintenance.Scheduled {
+		return nil
+	}
+
+	return []views.PaletteCommand{
+		{
+			Name:        "reschedule",
+			Description: "Move the maintenance to a different time",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveMaintenanceReschedule(ctx, &views.MaintenanceRescheduleInput{RunID: m.Run.Id}, "Reschedule")
+			},
+		},
+		{
+			Name:        "trigger",
+			Description: "Start the maintenance now",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveMaintenanceTrigger(ctx, views.MaintenanceTriggerInput{RunID: m.Run.Id}, "Trigger")
+			},
+		},
+	}
+}
+
+func init() {
+	stateFlag := command.NewEnumInput(maintenance.States, true)
+
+	maintenanceListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.MaintenanceListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*maintenance.Model, error) {
+			return views.LoadMaintenance(cmd.Context(), input)
+		}, text.MaintenanceTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveMaintenanceList(cmd.Context(), input, "Maintenance")
+		return nil
+	}
+
+	maintenanceListCmd.Flags().Var(stateFlag, "state", "Comma separated list of states to filter by")
+	maintenanceListCmd.Flags().StringSlice("resources", nil, "Comma separated list of service, Postgres or Key Value IDs to filter by")
+}
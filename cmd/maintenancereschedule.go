@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var maintenanceRescheduleCmd = &cobra.Command{
+	Use:   "reschedule [maintenanceID]",
+	Short: "Move a scheduled maintenance run to a different time",
+	Long: `Move a scheduled maintenance run to a different time. The time can be in RFC3339 format, for example
+2024-06-01T02:00:00Z, or relative to now, for example 12h or 3d. It must be before the deadline shown by
+maintenance list.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveMaintenanceReschedule = func(ctx context.Context, input *views.MaintenanceRescheduleInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, maintenanceRescheduleCmd, breadcrumb, input,
+		views.NewMaintenanceRescheduleView(ctx, input, maintenanceRescheduleCmd,
+			command.ShowResultFunc(ctx, maintenanceRescheduleCmd, "Rescheduled", input)))
+}
+
+func init() {
+	maintenanceRescheduleCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.MaintenanceRescheduleInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (string, error) {
+			return views.RescheduleMaintenance(cmd.Context(), input)
+		}, text.FormatString); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveMaintenanceReschedule(cmd.Context(), &input, "Reschedule")
+		return nil
+	}
+
+	maintenanceRescheduleCmd.Flags().String("at", "", "When to start the maintenance, in RFC3339 format or relative to now like 12h or 3d")
+}
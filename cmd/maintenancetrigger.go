@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var maintenanceTriggerCmd = &cobra.Command{
+	Use:   "trigger [maintenanceID]",
+	Short: "Start a scheduled maintenance run now",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveMaintenanceTrigger = func(ctx context.Context, input views.MaintenanceTriggerInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, maintenanceTriggerCmd, breadcrumb, &input, views.NewMaintenanceTriggerView(ctx, input))
+}
+
+func init() {
+	maintenanceTriggerCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.MaintenanceTriggerInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.TriggerMaintenance(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForTriggerMaintenance(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveMaintenanceTrigger(cmd.Context(), input, "Trigger")
+		return nil
+	}
+}
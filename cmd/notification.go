@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var notificationCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Manage notification settings",
+	Long: `Manage which service events send notifications in the active workspace, and override them for individual services.
+In interactive mode you can see every service's effective notification settings and change them.`,
+	GroupID: GroupManagement.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(notificationCmd)
+	notificationCmd.AddCommand(notificationShowCmd, notificationSetCmd, notificationListCmd, notificationOverrideCmd)
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var notificationListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the effective notification settings of every service",
+	Long: `List the effective notification settings of every service in the active workspace, including preview services.
+Settings that come from a service override rather than the workspace are marked with (override).`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveNotificationList = func(ctx context.Context, input views.NotificationListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, notificationListCmd, breadcrumb, &input, views.NewNotificationList(ctx, input,
+		func(ctx context.Context, s *notification.ServiceNotifications) tea.Cmd {
+			return InteractivePalette(ctx, commandsForServiceNotifications(s), notification.ServiceLabel(s))
+		},
+		tui.WithCustomOptions[*notification.ServiceNotifications]([]tui.CustomOption{
+			WithCopyID(ctx, notificationCmd),
+			WithWorkspaceSelection(ctx),
+		}),
+	))
+}
+
+func commandsForServiceNotifications(s *notification.ServiceNotifications) []views.PaletteCommand {
+	input := views.NotificationOverrideInput{
+		ServiceIDs: []string{s.ServiceID},
+		Notify:     string(clientnotifications.NotifyOverrideDefault),
+		Previews:   string(clientnotifications.NotifyPreviewOverrideDefault),
+	}
+	if s.OverridesNotify() {
+		input.Notify = string(s.Override.NotificationsToSend)
+	}
+	if s.OverridesPreviews() {
+		input.Previews = notification.OnOff(s.Override.PreviewNotificationsEnabled == clientnotifications.NotifyPreviewOverrideTrue)
+	}
+
+	return []views.PaletteCommand{
+		{
+			Name:        "override",
+			Description: "Change notifications for this service",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveNotificationOverride(ctx, &input, "Override")
+			},
+		},
+		{
+			Name:        "mute",
+			Description: "Turn off all notifications for this service",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveNotificationOverride(ctx, &views.NotificationOverrideInput{
+					ServiceIDs: []string{s.ServiceID},
+					Notify:     string(clientnotifications.NotifyOverrideNone),
+					Previews:   notification.Off,
+				}, "Mute")
+			},
+		},
+	}
+}
+
+func init() {
+	notificationListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.NotificationListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*notification.ServiceNotifications, error) {
+			return views.LoadServiceNotifications(cmd.Context(), input)
+		}, text.NotificationTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveNotificationList(cmd.Context(), input, "Service Notifications")
+		return nil
+	}
+
+	notificationListCmd.Flags().StringSliceP("environment-ids", "e", nil, "Comma separated list of environment ids to filter by")
+	notificationListCmd.Flags().Bool("previews-only", false, "Only list preview services")
+}
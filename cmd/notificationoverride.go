@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var notificationOverrideCmd = &cobra.Command{
+	Use:   "override [serviceID...]",
+	Short: "Override notification settings for one or more services",
+	Long: `Override the workspace notification settings for one or more services. Use default to go back to the
+workspace setting. For example, to mute several preview services:
+
+  render notifications override srv-123 srv-456 --notify none --previews off`,
+	Args: cobra.MinimumNArgs(1),
+}
+
+var InteractiveNotificationOverride = func(ctx context.Context, input *views.NotificationOverrideInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, notificationOverrideCmd, breadcrumb, input,
+		views.NewNotificationOverrideView(ctx, input, notificationOverrideCmd,
+			command.ShowResultFunc(ctx, notificationOverrideCmd, "Updated", input)))
+}
+
+func init() {
+	notifyFlag := command.NewEnumInput(notification.NotifyOverrideValues, false)
+	previewsFlag := command.NewEnumInput(notification.PreviewOverrideValues, false)
+
+	notificationOverrideCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.NotificationOverrideInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+		input.ServiceIDs = args
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*clientnotifications.NotificationServiceOverride, error) {
+			return views.OverrideServiceNotifications(cmd.Context(), input)
+		}, func(overrides []*clientnotifications.NotificationServiceOverride) string {
+			return text.FormatString(views.OverrideMessage(overrides))
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveNotificationOverride(cmd.Context(), &input, "Override")
+		return nil
+	}
+
+	notificationOverrideCmd.Flags().Var(notifyFlag, "notify", "Which events send notifications for the services, or default to use the workspace setting")
+	notificationOverrideCmd.Flags().Var(previewsFlag, "previews", "Whether the services' previews send notifications, or default to use the workspace setting")
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var notificationSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Change the notification settings of the active workspace",
+	Long: `Change the notification settings of the active workspace. Settings that aren't passed are left unchanged.
+Services use these settings unless they have an override, see notifications override.
+Slack notifications are connected in the Dashboard.`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveNotificationSet = func(ctx context.Context, input *views.NotificationSetInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, notificationSetCmd, breadcrumb, input,
+		views.NewNotificationSetView(ctx, input, notificationSetCmd,
+			command.ShowResultFunc(ctx, notificationSetCmd, "Updated", input)))
+}
+
+func init() {
+	notifyFlag := command.NewEnumInput(notification.NotifyValues, false)
+	previewsFlag := command.NewEnumInput(notification.OnOffValues, false)
+	emailFlag := command.NewEnumInput(notification.OnOffValues, false)
+
+	notificationSetCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.NotificationSetInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientnotifications.NotificationSetting, error) {
+			return views.SetNotificationSettings(cmd.Context(), input)
+		}, text.NotificationSettings); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveNotificationSet(cmd.Context(), &input, "Set Notifications")
+		return nil
+	}
+
+	notificationSetCmd.Flags().Var(notifyFlag, "notify", "Which service events send notifications")
+	notificationSetCmd.Flags().Var(previewsFlag, "previews", "Whether preview services send notifications")
+	notificationSetCmd.Flags().Var(emailFlag, "email", "Whether notifications are sent by email")
+}
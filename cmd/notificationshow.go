@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var notificationShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the notification settings of the active workspace",
+	Args:  cobra.NoArgs,
+}
+
+var InteractiveNotificationShow = func(ctx context.Context, input views.NotificationSettingsInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, notificationShowCmd, breadcrumb, &input, views.NewNotificationSettingsView(ctx, input))
+}
+
+func init() {
+	notificationShowCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.NotificationSettingsInput
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*clientnotifications.NotificationSetting, error) {
+			return views.LoadNotificationSettings(cmd.Context(), input)
+		}, text.NotificationSettings); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveNotificationShow(cmd.Context(), input, "Notifications")
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
 	"github.com/spf13/cobra"
 
 	"github.com/renderinc/cli/pkg/client"
@@ -17,7 +18,7 @@ var projectCmd = &cobra.Command{
 	Use:   "projects",
 	Short: "List projects",
 	Long: `List projects for the active workspace.
-In interactive mode you can view the environments for a project.`,
+In interactive mode you can view the environments for a project, and create, rename or delete projects.`,
 	GroupID: GroupManagement.ID,
 }
 
@@ -36,12 +37,40 @@ var InteractiveProjectList = func(ctx context.Context) {
 			tui.WithCustomOptions[*client.Project]([]tui.CustomOption{
 				WithCopyID(ctx, servicesCmd),
 				WithWorkspaceSelection(ctx),
+				{
+					Key:   "n",
+					Title: "New Project",
+					Function: func(row btable.Row) tea.Cmd {
+						return InteractiveProjectCreate(ctx, &views.ProjectCreateInput{}, "Create Project")
+					},
+				},
+				withProject("r", "Rename", func(p *client.Project) tea.Cmd {
+					return InteractiveProjectRename(ctx, &views.ProjectRenameInput{ProjectID: p.Id}, "Rename "+p.Name)
+				}),
+				withProject("d", "Delete", func(p *client.Project) tea.Cmd {
+					return InteractiveProjectDelete(ctx, views.ProjectDeleteInput{ProjectID: p.Id}, "Delete "+p.Name)
+				}),
 			}),
 		))
 }
 
+func withProject(key, title string, f func(p *client.Project) tea.Cmd) tui.CustomOption {
+	return tui.CustomOption{
+		Key:   key,
+		Title: title,
+		Function: func(row btable.Row) tea.Cmd {
+			p, ok := row.Data["project"].(*client.Project)
+			if !ok {
+				return nil
+			}
+			return f(p)
+		},
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(projectCmd)
+	projectCmd.AddCommand(projectCreateCmd, projectRenameCmd, projectDeleteCmd)
 
 	projectCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*client.Project, error) {
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var projectCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a project",
+	Long: `Create a project in the active workspace. A Production environment is created unless
+--environments is set.`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveProjectCreate = func(ctx context.Context, input *views.ProjectCreateInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, projectCreateCmd, breadcrumb, input,
+		views.NewProjectCreateView(ctx, input, projectCreateCmd, func(p *client.Project) tea.Cmd {
+			return InteractiveEnvironment(ctx, views.EnvironmentInput{ProjectID: p.Id}, "Environments for "+p.Name)
+		}),
+	)
+}
+
+func init() {
+	projectCreateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.ProjectCreateInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.Project, error) {
+			return views.CreateProject(cmd.Context(), input)
+		}, func(p *client.Project) string {
+			return text.FormatStringF("Created project %s (%s)", p.Name, p.Id)
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveProjectCreate(cmd.Context(), &input, "Create Project")
+		return nil
+	}
+
+	projectCreateCmd.Flags().String("name", "", "The name of the project")
+	projectCreateCmd.Flags().StringSlice("environments", nil, "Comma separated list of environment names to create. Defaults to Production")
+}
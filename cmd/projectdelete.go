@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var projectDeleteCmd = &cobra.Command{
+	Use:   "delete [projectID]",
+	Short: "Delete a project",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveProjectDelete = func(ctx context.Context, input views.ProjectDeleteInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, projectDeleteCmd, breadcrumb, &input, views.NewProjectDeleteView(ctx, input))
+}
+
+func init() {
+	projectDeleteCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.ProjectDeleteInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.DeleteProject(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForDeleteProject(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveProjectDelete(cmd.Context(), input, "Delete "+input.ProjectID)
+		return nil
+	}
+}
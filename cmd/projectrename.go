@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var projectRenameCmd = &cobra.Command{
+	Use:   "rename [projectID]",
+	Short: "Rename a project",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveProjectRename = func(ctx context.Context, input *views.ProjectRenameInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, projectRenameCmd, breadcrumb, input, views.NewProjectRenameView(ctx, input, projectRenameCmd, func(p *client.Project) tea.Cmd {
+		return command.ShowResultFunc(ctx, projectRenameCmd, "Renamed", input)(projectRenamedMessage(p))
+	}))
+}
+
+func init() {
+	projectRenameCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.ProjectRenameInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.Project, error) {
+			return views.RenameProject(cmd.Context(), input)
+		}, func(p *client.Project) string {
+			return text.FormatString(projectRenamedMessage(p))
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveProjectRename(cmd.Context(), &input, "Rename "+input.ProjectID)
+		return nil
+	}
+
+	projectRenameCmd.Flags().String("name", "", "The new name of the project")
+}
+
+func projectRenamedMessage(p *client.Project) string {
+	return fmt.Sprintf("Project %s renamed to %s", p.Id, p.Name)
+}
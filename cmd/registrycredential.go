@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var registryCredentialCmd = &cobra.Command{
+	Use:   "registry-credentials",
+	Short: "Manage private container registry credentials",
+	Long: `Manage the credentials services use to pull images from private container registries.
+Tokens are read from stdin or a file, never from a flag, so they stay out of shell history.
+In interactive mode you can see which services use each credential and rotate its token.`,
+	GroupID: GroupManagement.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(registryCredentialCmd)
+	registryCredentialCmd.AddCommand(registryCredentialListCmd, registryCredentialUsageCmd, registryCredentialAddCmd, registryCredentialRotateCmd, registryCredentialRemoveCmd)
+}
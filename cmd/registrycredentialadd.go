@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var registryCredentialAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a private container registry credential",
+	Long: `Add a private container registry credential. The token is read from --token-file, or from stdin when no file is given:
+
+  gh auth token | render registry-credentials add --name ghcr --registry GITHUB --username octocat -o text`,
+	Args: cobra.NoArgs,
+}
+
+var InteractiveRegistryCredentialAdd = func(ctx context.Context, input *views.RegistryCredentialCreateInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, registryCredentialAddCmd, breadcrumb, input,
+		views.NewRegistryCredentialCreateView(ctx, input, registryCredentialAddCmd, func(cred *client.RegistryCredential) tea.Cmd {
+			return InteractiveRegistryCredentialList(ctx, views.RegistryCredentialListInput{}, "Registry Credentials")
+		}),
+	)
+}
+
+func init() {
+	registryFlag := command.NewEnumInput(registrycredential.Registries, false)
+
+	registryCredentialAddCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RegistryCredentialCreateInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.RegistryCredential, error) {
+			return views.CreateRegistryCredential(cmd.Context(), input)
+		}, func(cred *client.RegistryCredential) string {
+			return text.FormatStringF("Created registry credential %s (%s) for %s", cred.Name, cred.Id, cred.Registry)
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRegistryCredentialAdd(cmd.Context(), &input, "Add Registry Credential")
+		return nil
+	}
+
+	registryCredentialAddCmd.Flags().String("name", "", "A descriptive name for the credential")
+	registryCredentialAddCmd.Flags().Var(registryFlag, "registry", "The registry the credential is for")
+	registryCredentialAddCmd.Flags().String("username", "", "The registry username")
+	registryCredentialAddCmd.Flags().String("token-file", "", "Path of a file containing the token. Reads stdin if not set")
+}
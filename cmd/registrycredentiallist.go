@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var registryCredentialListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registry credentials",
+	Args:  cobra.NoArgs,
+}
+
+var InteractiveRegistryCredentialList = func(ctx context.Context, input views.RegistryCredentialListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, registryCredentialListCmd, breadcrumb, &input, views.NewRegistryCredentialList(ctx, input,
+		func(ctx context.Context, cred *client.RegistryCredential) tea.Cmd {
+			return InteractivePalette(ctx, commandsForRegistryCredential(cred), cred.Name)
+		},
+		tui.WithCustomOptions[*client.RegistryCredential]([]tui.CustomOption{
+			WithCopyID(ctx, registryCredentialCmd),
+			WithWorkspaceSelection(ctx),
+		}),
+	))
+}
+
+func commandsForRegistryCredential(cred *client.RegistryCredential) []views.PaletteCommand {
+	return []views.PaletteCommand{
+		{
+			Name:        "usage",
+			Description: "List the services that use this credential",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveRegistryCredentialUsage(ctx, views.RegistryCredentialUsageInput{CredentialID: cred.Id}, "Usage")
+			},
+		},
+		{
+			Name:        "rotate",
+			Description: "Replace the token of this credential",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveRegistryCredentialRotate(ctx, &views.RegistryCredentialRotateInput{CredentialID: cred.Id}, "Rotate")
+			},
+		},
+		{
+			Name:        "remove",
+			Description: "Delete this credential",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveRegistryCredentialRemove(ctx, views.RegistryCredentialDeleteInput{CredentialID: cred.Id}, "Remove")
+			},
+		},
+	}
+}
+
+func init() {
+	registryFlag := command.NewEnumInput(registrycredential.Registries, true)
+
+	registryCredentialListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RegistryCredentialListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*client.RegistryCredential, error) {
+			return views.LoadRegistryCredentials(cmd.Context(), input)
+		}, text.RegistryCredentialTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRegistryCredentialList(cmd.Context(), input, "Registry Credentials")
+		return nil
+	}
+
+	registryCredentialListCmd.Flags().Var(registryFlag, "registry", "Comma separated list of registries to filter by")
+}
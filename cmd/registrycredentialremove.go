@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var registryCredentialRemoveCmd = &cobra.Command{
+	Use:   "rm [credentialID]",
+	Short: "Delete a registry credential",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveRegistryCredentialRemove = func(ctx context.Context, input views.RegistryCredentialDeleteInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, registryCredentialRemoveCmd, breadcrumb, &input, views.NewRegistryCredentialDeleteView(ctx, input))
+}
+
+func init() {
+	registryCredentialRemoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RegistryCredentialDeleteInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.DeleteRegistryCredential(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) {
+				return views.RequireConfirmationForDeleteRegistryCredential(cmd.Context(), input)
+			},
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRegistryCredentialRemove(cmd.Context(), input, "Remove "+input.CredentialID)
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var registryCredentialRotateCmd = &cobra.Command{
+	Use:   "rotate [credentialID]",
+	Short: "Replace the token of a registry credential",
+	Long: `Replace the token of a registry credential and list the services that will pull with it. The token is read
+from --token-file, or from stdin when no file is given. Services pick up the new token on their next deploy.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveRegistryCredentialRotate = func(ctx context.Context, input *views.RegistryCredentialRotateInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, registryCredentialRotateCmd, breadcrumb, input,
+		views.NewRegistryCredentialRotateView(ctx, input, registryCredentialRotateCmd,
+			command.ShowResultFunc(ctx, registryCredentialRotateCmd, "Rotated", input)))
+}
+
+func init() {
+	registryCredentialRotateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RegistryCredentialRotateInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*registrycredential.Usage, error) {
+			return views.RotateRegistryCredential(cmd.Context(), input)
+		}, text.RegistryCredentialRotate); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRegistryCredentialRotate(cmd.Context(), &input, "Rotate")
+		return nil
+	}
+
+	registryCredentialRotateCmd.Flags().String("username", "", "A new registry username. Keeps the current username if not set")
+	registryCredentialRotateCmd.Flags().String("token-file", "", "Path of a file containing the token. Reads stdin if not set")
+}
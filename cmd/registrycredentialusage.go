@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/service"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var registryCredentialUsageCmd = &cobra.Command{
+	Use:   "usage [credentialID]",
+	Short: "List the services that pull their image with each registry credential",
+	Long: `List the services that pull their image with each registry credential, or with a single credential if one is given.
+Credentials no service uses are listed as unused.`,
+	Args: cobra.MaximumNArgs(1),
+}
+
+var InteractiveRegistryCredentialUsage = func(ctx context.Context, input views.RegistryCredentialUsageInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, registryCredentialUsageCmd, breadcrumb, &input, views.NewRegistryCredentialUsageList(ctx, input,
+		func(ctx context.Context, svc *service.Model) tea.Cmd {
+			return InteractivePalette(ctx, selectResource(ctx)(svc), resource.BreadcrumbForResource(svc))
+		},
+		tui.WithCustomOptions[*registrycredential.Usage]([]tui.CustomOption{
+			WithCopyID(ctx, registryCredentialCmd),
+		}),
+	))
+}
+
+func init() {
+	registryCredentialUsageCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RegistryCredentialUsageInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*registrycredential.Usage, error) {
+			return views.LoadRegistryCredentialUsage(cmd.Context(), input)
+		}, text.RegistryCredentialUsageTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRegistryCredentialUsage(cmd.Context(), input, "Registry Credential Usage")
+		return nil
+	}
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var routeCmd = &cobra.Command{
+	Use:   "routes",
+	Short: "Manage redirect and rewrite rules for static sites",
+	Long: `Manage redirect and rewrite rules for static sites.
+Rules are applied in priority order. Use apply to replace every rule at once from a file, including Netlify _redirects files.`,
+	GroupID: GroupCore.ID,
+}
+
+func init() {
+	rootCmd.AddCommand(routeCmd)
+	routeCmd.AddCommand(routeListCmd, routeAddCmd, routeRemoveCmd, routeApplyCmd)
+}
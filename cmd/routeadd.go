@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var routeAddCmd = &cobra.Command{
+	Use:   "add [serviceID]",
+	Short: "Add a redirect or rewrite rule to a static site",
+	Long: `Add a redirect or rewrite rule to a static site. The rule is added with the lowest priority.
+Use routes apply to set the order of all rules at once.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveRouteAdd = func(ctx context.Context, input *views.RouteAddInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(
+		ctx,
+		routeAddCmd,
+		breadcrumb,
+		input,
+		views.NewRouteAddView(ctx, input, routeAddCmd, func(r *client.Route) tea.Cmd {
+			return InteractiveRouteList(ctx, views.RouteListInput{ServiceID: input.ServiceID}, "Routes")
+		}),
+	)
+}
+
+func init() {
+	routeTypeFlag := command.NewEnumInput([]string{string(client.RouteTypeRedirect), string(client.RouteTypeRewrite)}, false)
+
+	routeAddCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RouteAddInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*client.Route, error) {
+			return views.AddRoute(cmd.Context(), input)
+		}, func(r *client.Route) string {
+			return text.FormatStringF("Added %s rule %s -> %s with priority %d", r.Type, r.Source, r.Destination, r.Priority)
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRouteAdd(cmd.Context(), &input, "Add rule")
+		return nil
+	}
+
+	routeAddCmd.Flags().Var(routeTypeFlag, "type", "The type of rule. Defaults to redirect")
+	routeAddCmd.Flags().String("source", "", "The path to match, e.g. /blog/*")
+	routeAddCmd.Flags().String("destination", "", "The path or URL to redirect or rewrite to")
+}
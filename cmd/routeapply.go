@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/rulefile"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var routeApplyCmd = &cobra.Command{
+	Use:   "apply [serviceID]",
+	Short: "Replace all redirect and rewrite rules of a static site with rules from a file",
+	Long: `Replace all redirect and rewrite rules of a static site with the rules in a file, in a single request.
+Rules are given priority in the order they appear in the file.
+
+Supported formats:
+  netlify  A Netlify _redirects file. A 200 status is imported as a rewrite, 3xx statuses as redirects.
+           Rules using other statuses, query parameters or conditions are skipped and reported.
+  yaml     A list of rules with type, source and destination keys, either at the top level or under a
+           routes key, matching the routes section of render.yaml.
+  json     The same list as JSON. The output of "render routes list -o json" can be applied directly.
+
+The format is detected from the file extension when --format is not set. Files without a .yaml, .yml or .json
+extension are read as Netlify _redirects files.
+
+A file without any supported rules is rejected, since applying it would remove every rule from the service.
+Set --allow-empty to do that on purpose.`,
+	Args: cobra.ExactArgs(1),
+}
+
+var InteractiveRouteApply = func(ctx context.Context, input views.RouteApplyInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, routeApplyCmd, breadcrumb, &input, views.NewRouteApplyView(ctx, input))
+}
+
+func init() {
+	formatFlag := command.NewEnumInput(rulefile.Formats, false)
+
+	routeApplyCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RouteApplyInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (*route.ApplyResult, error) { return views.ApplyRoutes(cmd.Context(), input) },
+			text.RouteApply(input.ServiceID),
+			func() (string, error) { return views.RequireConfirmationForApplyRoutes(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRouteApply(cmd.Context(), input, "Apply routes")
+		return nil
+	}
+
+	routeApplyCmd.Flags().String("file", "", "Path to the file containing the rules")
+	routeApplyCmd.Flags().Var(formatFlag, "format", "The format of the file. Detected from the file name if not set")
+	routeApplyCmd.Flags().Bool("allow-empty", false, "Apply a file without any rules, removing all rules from the service")
+	if err := routeApplyCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var routeListCmd = &cobra.Command{
+	Use:   "list [serviceID]",
+	Short: "List redirect and rewrite rules for a static site in priority order",
+	Args:  cobra.ExactArgs(1),
+}
+
+var InteractiveRouteList = func(ctx context.Context, input views.RouteListInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, routeListCmd, breadcrumb, &input, views.NewRouteList(ctx, input,
+		func(ctx context.Context, r *client.Route) tea.Cmd {
+			return InteractivePalette(ctx, commandsForRoute(input.ServiceID, r), r.Source)
+		},
+		tui.WithCustomOptions[*client.Route]([]tui.CustomOption{
+			WithCopyID(ctx, routeCmd),
+		}),
+	))
+}
+
+func commandsForRoute(serviceID string, r *client.Route) []views.PaletteCommand {
+	return []views.PaletteCommand{
+		{
+			Name:        "remove",
+			Description: "Remove the rule",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveRouteRemove(ctx, views.RouteRemoveInput{ServiceID: serviceID, RouteID: r.Id}, "Remove rule")
+			},
+		},
+	}
+}
+
+func init() {
+	routeListCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RouteListInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return fmt.Errorf("failed to parse command: %w", err)
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() ([]*client.Route, error) {
+			return views.LoadRoutes(cmd.Context(), input)
+		}, text.RouteTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRouteList(cmd.Context(), input, "Routes")
+		return nil
+	}
+}
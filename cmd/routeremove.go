@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/text"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+var routeRemoveCmd = &cobra.Command{
+	Use:   "remove [serviceID] [routeID]",
+	Short: "Remove a redirect or rewrite rule from a static site",
+	Args:  cobra.ExactArgs(2),
+}
+
+var InteractiveRouteRemove = func(ctx context.Context, input views.RouteRemoveInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, routeRemoveCmd, breadcrumb, &input, views.NewRouteRemoveView(ctx, input))
+}
+
+func init() {
+	routeRemoveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.RouteRemoveInput
+		err := command.ParseCommand(cmd, args, &input)
+		if err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithConfirm(
+			cmd,
+			func() (string, error) { return views.RemoveRoute(cmd.Context(), input) },
+			text.FormatString,
+			func() (string, error) { return views.RequireConfirmationForRemoveRoute(cmd.Context(), input) },
+		); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveRouteRemove(cmd.Context(), input, "Remove rule")
+		return nil
+	}
+}
@@ -149,6 +149,66 @@ func selectResource(ctx context.Context) func(resource.Resource) []views.Palette
 				},
 				allowedTypes: service.NonStaticTypes,
 			},
+			{
+				command: views.PaletteCommand{
+					Name:        "run now",
+					Description: "Trigger a run of the cron job and tail logs",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveCronRun(ctx, views.CronRunInput{CronJobID: r.ID()}, "Run")
+					},
+				},
+				allowedTypes: []string{service.CronJobResourceType},
+			},
+			{
+				command: views.PaletteCommand{
+					Name:        "cancel run",
+					Description: "Cancel the active run of the cron job",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveCronCancel(ctx, views.CronCancelInput{CronJobID: r.ID()}, "Cancel run")
+					},
+				},
+				allowedTypes: []string{service.CronJobResourceType},
+			},
+			{
+				command: views.PaletteCommand{
+					Name:        "disks",
+					Description: "List disks attached to the service",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveDiskList(ctx, views.DiskListInput{ServiceID: r.ID()}, "Disks")
+					},
+				},
+				allowedTypes: service.NonStaticServerTypes,
+			},
+			{
+				command: views.PaletteCommand{
+					Name:        "domains",
+					Description: "List custom domains for the service",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveDomainList(ctx, views.DomainListInput{ServiceID: r.ID()}, "Domains")
+					},
+				},
+				allowedTypes: []string{service.WebServiceResourceType, service.StaticSiteResourceType},
+			},
+			{
+				command: views.PaletteCommand{
+					Name:        "routes",
+					Description: "List redirect and rewrite rules for the static site",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveRouteList(ctx, views.RouteListInput{ServiceID: r.ID()}, "Routes")
+					},
+				},
+				allowedTypes: []string{service.StaticSiteResourceType},
+			},
+			{
+				command: views.PaletteCommand{
+					Name:        "headers",
+					Description: "List response header rules for the static site",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveHeaderList(ctx, views.HeaderListInput{ServiceID: r.ID()}, "Headers")
+					},
+				},
+				allowedTypes: []string{service.StaticSiteResourceType},
+			},
 			{
 				command: views.PaletteCommand{
 					Name:        "dashboard",
@@ -0,0 +1,18 @@
+// Package backoff spaces out repeated requests, such as polling for a status or reconnecting a stream
+package backoff
+
+import "time"
+
+// Exponential doubles the delay between attempts from Min up to Max
+type Exponential struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Next returns the delay to wait after waiting d. A zero d starts at Min.
+func (e Exponential) Next(d time.Duration) time.Duration {
+	if d < e.Min {
+		return e.Min
+	}
+	return min(d*2, e.Max)
+}
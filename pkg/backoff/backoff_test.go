@@ -0,0 +1,25 @@
+package backoff_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renderinc/cli/pkg/backoff"
+)
+
+func TestExponential(t *testing.T) {
+	b := backoff.Exponential{Min: time.Second, Max: 30 * time.Second}
+
+	var intervals []time.Duration
+	var d time.Duration
+	for range 7 {
+		d = b.Next(d)
+		intervals = append(intervals, d)
+	}
+
+	assert.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second,
+	}, intervals)
+}
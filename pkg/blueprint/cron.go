@@ -0,0 +1,96 @@
+package blueprint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// 7 is accepted as Sunday like most cron implementations
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// ValidateCronSchedule checks a standard five field cron expression, e.g. "*/15 * * * *"
+func ValidateCronSchedule(schedule string) error {
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			if err := cronFields[i].validatePart(part); err != nil {
+				return fmt.Errorf("invalid %s field %q: %w", cronFields[i].name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) validatePart(part string) error {
+	if part == "" {
+		return fmt.Errorf("empty value")
+	}
+
+	rng, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+
+	if rng == "*" {
+		return nil
+	}
+
+	lo, hi, isRange := strings.Cut(rng, "-")
+	start, err := f.value(lo)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+
+	end, err := f.value(hi)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("range %s is backwards", rng)
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,424 @@
+package blueprint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientautoscaling "github.com/renderinc/cli/pkg/client/autoscaling"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/envgroup"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/postgres"
+	"github.com/renderinc/cli/pkg/redis"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/service"
+)
+
+// exportConcurrency limits how many resources are fetched at once so large workspaces don't hit rate limits
+const exportConcurrency = 8
+
+type ExportParams struct {
+	EnvironmentIDs []string
+	// IncludeValues exports env var values that don't look like secrets instead of sync: false placeholders
+	IncludeValues bool
+	// IncludeSecrets exports all env var values, including ones that look like secrets
+	IncludeSecrets bool
+	// Names limits the export to resources and env groups with these names
+	Names []string
+}
+
+func (p ExportParams) includes(name string) bool {
+	return len(p.Names) == 0 || slices.Contains(p.Names, name)
+}
+
+// Exporter builds a Blueprint spec from the live resources in a workspace
+type Exporter struct {
+	resourceService *resource.Service
+	serviceRepo     *service.Repo
+	envGroupRepo    *envgroup.Repo
+	domainRepo      *domain.Repo
+	routeRepo       *route.Repo
+	headerRepo      *header.Repo
+}
+
+func NewExporter(resourceService *resource.Service, c *client.ClientWithResponses) *Exporter {
+	return &Exporter{
+		resourceService: resourceService,
+		serviceRepo:     service.NewRepo(c),
+		envGroupRepo:    envgroup.NewRepo(c),
+		domainRepo:      domain.NewRepo(c),
+		routeRepo:       route.NewRepo(c),
+		headerRepo:      header.NewRepo(c),
+	}
+}
+
+func (e *Exporter) Export(ctx context.Context, params ExportParams) (*Spec, error) {
+	resources, err := e.resourceService.ListResources(ctx, resource.ResourceParams{EnvironmentIDs: params.EnvironmentIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	groups, groupsByService, err := e.exportEnvGroups(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{EnvVarGroups: groups}
+
+	var services []*service.Model
+	for _, r := range resources {
+		if !params.includes(r.Name()) {
+			continue
+		}
+		switch m := r.(type) {
+		case *service.Model:
+			services = append(services, m)
+		case *postgres.Model:
+			spec.Databases = append(spec.Databases, ExportPostgres(m.Postgres))
+		case *redis.Model:
+			spec.Services = append(spec.Services, ExportRedis(m.Redis))
+		}
+	}
+
+	exported := make([]ServiceSpec, len(services))
+	wg, ctx := errgroup.WithContext(ctx)
+	wg.SetLimit(exportConcurrency)
+	for i, svc := range services {
+		wg.Go(func() error {
+			s, err := e.exportService(ctx, svc.Service, params)
+			if err != nil {
+				return fmt.Errorf("failed to export service %s: %w", svc.Service.Name, err)
+			}
+			for _, group := range groupsByService[svc.Service.Id] {
+				s.EnvVars = append(s.EnvVars, EnvVarSpec{FromGroup: group})
+			}
+			exported[i] = *s
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	spec.Services = append(exported, spec.Services...)
+	return spec, nil
+}
+
+// exportEnvGroups returns the env groups to export and the names of the groups linked to each service ID
+func (e *Exporter) exportEnvGroups(ctx context.Context, params ExportParams) ([]EnvVarGroupSpec, map[string][]string, error) {
+	listParams := &client.ListEnvGroupsParams{}
+	if len(params.EnvironmentIDs) > 0 {
+		listParams.EnvironmentId = pointers.From(params.EnvironmentIDs)
+	}
+
+	metas, err := e.envGroupRepo.ListEnvGroups(ctx, listParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := make([]EnvVarGroupSpec, 0, len(metas))
+	groupsByService := map[string][]string{}
+	for _, meta := range metas {
+		if !params.includes(meta.Name) {
+			continue
+		}
+		group, err := e.envGroupRepo.GetEnvGroup(ctx, meta.Id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get env group %s: %w", meta.Name, err)
+		}
+
+		spec := EnvVarGroupSpec{Name: group.Name}
+		for _, ev := range group.EnvVars {
+			spec.EnvVars = append(spec.EnvVars, exportEnvVar(ev.Key, ev.Value, params))
+		}
+		groups = append(groups, spec)
+
+		for _, link := range meta.ServiceLinks {
+			groupsByService[link.Id] = append(groupsByService[link.Id], group.Name)
+		}
+	}
+
+	return groups, groupsByService, nil
+}
+
+func (e *Exporter) exportService(ctx context.Context, svc *client.Service, params ExportParams) (*ServiceSpec, error) {
+	s, err := ExportService(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars, err := e.serviceRepo.ListEnvVars(ctx, svc.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range envVars {
+		s.EnvVars = append(s.EnvVars, exportEnvVar(ev.Key, ev.Value, params))
+	}
+
+	if svc.Type != client.WebService && svc.Type != client.StaticSite {
+		return s, nil
+	}
+
+	domains, err := e.domainRepo.ListCustomDomains(ctx, svc.Id, &client.ListCustomDomainsParams{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range domains {
+		// the www redirect of an apex domain is created automatically, so only the apex is listed
+		if d.RedirectForName != "" {
+			continue
+		}
+		s.Domains = append(s.Domains, d.Name)
+	}
+
+	if svc.Type != client.StaticSite {
+		return s, nil
+	}
+
+	routes, err := e.routeRepo.ListRoutes(ctx, svc.Id, &client.ListRoutesParams{})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		s.Routes = append(s.Routes, RouteSpec{Type: string(r.Type), Source: r.Source, Destination: r.Destination})
+	}
+
+	headers, err := e.headerRepo.ListHeaders(ctx, svc.Id, &client.ListHeadersParams{})
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range headers {
+		s.Headers = append(s.Headers, HeaderSpec{Path: h.Path, Name: h.Name, Value: h.Value})
+	}
+
+	return s, nil
+}
+
+// ExportService converts the settings of a service to its Blueprint equivalent. Env vars, domains, routes and
+// headers are fetched separately and are not included.
+func ExportService(svc *client.Service) (*ServiceSpec, error) {
+	s := &ServiceSpec{
+		Name:    svc.Name,
+		RootDir: svc.RootDir,
+	}
+
+	if svc.Repo != nil {
+		s.Repo = *svc.Repo
+		s.Branch = pointers.StringValue(svc.Branch)
+		s.AutoDeploy = pointers.From(svc.AutoDeploy == client.AutoDeployYes)
+	}
+	if svc.BuildFilter != nil && (len(svc.BuildFilter.Paths) > 0 || len(svc.BuildFilter.IgnoredPaths) > 0) {
+		s.BuildFilter = &BuildFilterSpec{Paths: svc.BuildFilter.Paths, IgnoredPaths: svc.BuildFilter.IgnoredPaths}
+	}
+	if svc.ImagePath != nil {
+		s.Image = &ImageSpec{URL: *svc.ImagePath}
+	}
+
+	var server *serverDetails
+	switch svc.Type {
+	case client.StaticSite:
+		details, err := svc.ServiceDetails.AsStaticSiteDetails()
+		if err != nil {
+			return nil, err
+		}
+		s.Type = ServiceTypeWeb
+		s.Runtime = RuntimeStatic
+		s.BuildCommand = details.BuildCommand
+		s.StaticPublishPath = details.PublishPath
+		return s, nil
+	case client.WebService:
+		details, err := svc.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return nil, err
+		}
+		s.Type = ServiceTypeWeb
+		s.HealthCheckPath = details.HealthCheckPath
+		server = &serverDetails{details.Runtime, details.Plan, details.Region, details.EnvSpecificDetails, details.NumInstances, details.Autoscaling, details.Disk}
+	case client.PrivateService:
+		details, err := svc.ServiceDetails.AsPrivateServiceDetails()
+		if err != nil {
+			return nil, err
+		}
+		s.Type = ServiceTypePserv
+		server = &serverDetails{details.Runtime, details.Plan, details.Region, details.EnvSpecificDetails, details.NumInstances, details.Autoscaling, details.Disk}
+	case client.BackgroundWorker:
+		details, err := svc.ServiceDetails.AsBackgroundWorkerDetails()
+		if err != nil {
+			return nil, err
+		}
+		s.Type = ServiceTypeWorker
+		server = &serverDetails{details.Runtime, details.Plan, details.Region, details.EnvSpecificDetails, details.NumInstances, details.Autoscaling, details.Disk}
+	case client.CronJob:
+		details, err := svc.ServiceDetails.AsCronJobDetails()
+		if err != nil {
+			return nil, err
+		}
+		s.Type = ServiceTypeCron
+		s.Schedule = details.Schedule
+		server = &serverDetails{runtime: details.Runtime, plan: details.Plan, region: details.Region, envSpecificDetails: details.EnvSpecificDetails}
+	default:
+		return nil, fmt.Errorf("unsupported service type %s", svc.Type)
+	}
+
+	s.Runtime = string(server.runtime)
+	s.Plan = blueprintPlan(servicePlanNames, string(server.plan))
+	s.Region = string(server.region)
+
+	if err := exportCommands(s, server.envSpecificDetails); err != nil {
+		return nil, err
+	}
+
+	if server.autoscaling != nil && server.autoscaling.Enabled {
+		s.Scaling = &ScalingSpec{MinInstances: server.autoscaling.Min, MaxInstances: server.autoscaling.Max}
+		if server.autoscaling.Criteria.Cpu.Enabled {
+			s.Scaling.TargetCPUPercent = server.autoscaling.Criteria.Cpu.Percentage
+		}
+		if server.autoscaling.Criteria.Memory.Enabled {
+			s.Scaling.TargetMemoryPercent = server.autoscaling.Criteria.Memory.Percentage
+		}
+	} else if server.numInstances > 1 {
+		s.NumInstances = server.numInstances
+	}
+
+	if server.disk != nil {
+		s.Disk = &DiskSpec{Name: server.disk.Name, MountPath: server.disk.MountPath, SizeGB: server.disk.SizeGB}
+	}
+
+	return s, nil
+}
+
+func exportCommands(s *ServiceSpec, details client.EnvSpecificDetails) error {
+	switch s.Runtime {
+	case RuntimeImage:
+		return nil
+	case RuntimeDocker:
+		docker, err := details.AsDockerDetails()
+		if err != nil {
+			return err
+		}
+		s.DockerfilePath = docker.DockerfilePath
+		s.DockerContext = docker.DockerContext
+		s.DockerCommand = docker.DockerCommand
+		s.PreDeployCommand = pointers.StringValue(docker.PreDeployCommand)
+	default:
+		native, err := details.AsNativeEnvironmentDetails()
+		if err != nil {
+			return err
+		}
+		s.BuildCommand = native.BuildCommand
+		s.StartCommand = native.StartCommand
+		s.PreDeployCommand = pointers.StringValue(native.PreDeployCommand)
+	}
+	return nil
+}
+
+func ExportPostgres(db *client.Postgres) DatabaseSpec {
+	d := DatabaseSpec{
+		Name:                 db.Name,
+		DatabaseName:         db.DatabaseName,
+		User:                 db.DatabaseUser,
+		Plan:                 blueprintPlan(databasePlanNames, string(db.Plan)),
+		Region:               string(db.Region),
+		PostgresMajorVersion: string(db.Version),
+		DiskSizeGB:           pointers.ValueOrDefault(db.DiskSizeGB, 0),
+		IPAllowList:          exportIPAllowList(db.IpAllowList),
+	}
+	if db.HighAvailabilityEnabled {
+		d.HighAvailability = &HASpec{Enabled: true}
+	}
+	return d
+}
+
+func ExportRedis(db *client.Redis) ServiceSpec {
+	ipAllowList := exportIPAllowList(db.IpAllowList)
+	if ipAllowList == nil {
+		// an empty list is required and means only internal connections are allowed
+		ipAllowList = []IPAllowSpec{}
+	}
+
+	return ServiceSpec{
+		Type:            ServiceTypeKeyValue,
+		Name:            db.Name,
+		Plan:            blueprintPlan(servicePlanNames, string(db.Plan)),
+		Region:          string(db.Region),
+		IPAllowList:     &ipAllowList,
+		MaxmemoryPolicy: pointers.StringValue(db.Options.MaxmemoryPolicy),
+	}
+}
+
+type serverDetails struct {
+	runtime            client.ServiceRuntime
+	plan               client.Plan
+	region             client.Region
+	envSpecificDetails client.EnvSpecificDetails
+	numInstances       int
+	autoscaling        *clientautoscaling.AutoscalingConfig
+	disk               *clientdisks.Disk
+}
+
+func exportIPAllowList(list []client.CidrBlockAndDescription) []IPAllowSpec {
+	var res []IPAllowSpec
+	for _, block := range list {
+		res = append(res, IPAllowSpec{Source: block.CidrBlock, Description: block.Description})
+	}
+	return res
+}
+
+var secretKeyRegex = regexp.MustCompile(`(?i)(SECRET|TOKEN|PASSWORD|PASSWD|PASS|PWD|PRIVATE|CREDENTIAL|API_?KEY|ACCESS_?KEY|AUTH|SALT|SIGNING|DSN|CERT)`)
+
+// LooksSecret guesses whether an env var holds a secret from its key, or from its value for URLs that embed a password
+func LooksSecret(key, value string) bool {
+	if secretKeyRegex.MatchString(key) {
+		return true
+	}
+	if u, err := url.Parse(value); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func exportEnvVar(key, value string, params ExportParams) EnvVarSpec {
+	if params.IncludeSecrets || (params.IncludeValues && !LooksSecret(key, value)) {
+		return EnvVarSpec{Key: key, Value: value}
+	}
+	return EnvVarSpec{Key: key, Sync: pointers.From(false)}
+}
+
+const exportHeader = `# Generated by "render blueprints export". Env vars with sync: false are placeholders, you are prompted
+# for their values when the Blueprint is first synced.
+`
+
+// MarshalSpec formats a spec as a render.yaml file
+func MarshalSpec(spec *Spec) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(exportHeader)
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(spec); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ExportSummary describes what was written by an export to a file
+func ExportSummary(spec *Spec, file string) string {
+	return fmt.Sprintf("Exported %d services, %d databases and %d env groups to %s",
+		len(spec.Services), len(spec.Databases), len(spec.EnvVarGroups), file)
+}
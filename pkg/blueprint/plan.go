@@ -0,0 +1,298 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+
+	KindService     = "service"
+	KindDatabase    = "database"
+	KindEnvVarGroup = "envVarGroup"
+)
+
+// FieldChange is a single field that differs between render.yaml and the live resource. Env var values are
+// never included so plans are safe to print in CI logs.
+type FieldChange struct {
+	Action string `json:"action"`
+	Field  string `json:"field"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+type ResourceChange struct {
+	Action  string        `json:"action"`
+	Kind    string        `json:"kind"`
+	Name    string        `json:"name"`
+	Type    string        `json:"type,omitempty"`
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+type Plan struct {
+	File    string           `json:"file"`
+	Changes []ResourceChange `json:"changes"`
+	Creates int              `json:"creates"`
+	Updates int              `json:"updates"`
+	Deletes int              `json:"deletes"`
+}
+
+// HasDrift returns true if applying the file would change any live resource
+func (p *Plan) HasDrift() bool {
+	return len(p.Changes) > 0
+}
+
+// Names returns the names of all resources and env groups in the spec, including env groups referenced with
+// fromGroup
+func (s *Spec) Names() []string {
+	var names []string
+	for _, svc := range s.Services {
+		names = append(names, svc.Name)
+		for _, ev := range svc.EnvVars {
+			if ev.FromGroup != "" {
+				names = append(names, ev.FromGroup)
+			}
+		}
+	}
+	for _, db := range s.Databases {
+		names = append(names, db.Name)
+	}
+	for _, group := range s.EnvVarGroups {
+		names = append(names, group.Name)
+	}
+	return names
+}
+
+// Diff compares the desired spec from render.yaml with the live spec. Only fields set in render.yaml are
+// compared, since unset fields fall back to defaults that the API always returns. Live resources missing from
+// render.yaml are only reported as deletes when includeDeletes is set, because live is otherwise the whole
+// workspace rather than the resources managed by the Blueprint.
+func Diff(desired, live *Spec, includeDeletes bool) *Plan {
+	plan := &Plan{Changes: []ResourceChange{}}
+
+	liveServices := map[string]ServiceSpec{}
+	for _, svc := range live.Services {
+		liveServices[svc.Name] = svc
+	}
+	for _, svc := range desired.Services {
+		svc.Type = normalizeServiceType(svc.Type)
+		current, ok := liveServices[svc.Name]
+		delete(liveServices, svc.Name)
+		if !ok {
+			plan.add(ResourceChange{Action: ActionCreate, Kind: KindService, Name: svc.Name, Type: svc.Type})
+			continue
+		}
+
+		changes := diffFields(withoutEnvVars(svc), withoutEnvVars(withDefaultInstances(current)))
+		changes = append(changes, diffEnvVars(svc.EnvVars, current.EnvVars)...)
+		if len(changes) > 0 {
+			plan.add(ResourceChange{Action: ActionUpdate, Kind: KindService, Name: svc.Name, Type: svc.Type, Changes: changes})
+		}
+	}
+
+	liveDatabases := map[string]DatabaseSpec{}
+	for _, db := range live.Databases {
+		liveDatabases[db.Name] = db
+	}
+	for _, db := range desired.Databases {
+		current, ok := liveDatabases[db.Name]
+		delete(liveDatabases, db.Name)
+		if !ok {
+			plan.add(ResourceChange{Action: ActionCreate, Kind: KindDatabase, Name: db.Name})
+			continue
+		}
+		if changes := diffFields(db, current); len(changes) > 0 {
+			plan.add(ResourceChange{Action: ActionUpdate, Kind: KindDatabase, Name: db.Name, Changes: changes})
+		}
+	}
+
+	liveGroups := map[string]EnvVarGroupSpec{}
+	for _, group := range live.EnvVarGroups {
+		liveGroups[group.Name] = group
+	}
+	for _, group := range desired.EnvVarGroups {
+		current, ok := liveGroups[group.Name]
+		delete(liveGroups, group.Name)
+		if !ok {
+			plan.add(ResourceChange{Action: ActionCreate, Kind: KindEnvVarGroup, Name: group.Name})
+			continue
+		}
+		if changes := diffEnvVars(group.EnvVars, current.EnvVars); len(changes) > 0 {
+			plan.add(ResourceChange{Action: ActionUpdate, Kind: KindEnvVarGroup, Name: group.Name, Changes: changes})
+		}
+	}
+
+	if includeDeletes {
+		for _, name := range sortedKeys(liveServices) {
+			plan.add(ResourceChange{Action: ActionDelete, Kind: KindService, Name: name, Type: liveServices[name].Type})
+		}
+		for _, name := range sortedKeys(liveDatabases) {
+			plan.add(ResourceChange{Action: ActionDelete, Kind: KindDatabase, Name: name})
+		}
+		for _, name := range sortedKeys(liveGroups) {
+			plan.add(ResourceChange{Action: ActionDelete, Kind: KindEnvVarGroup, Name: name})
+		}
+	}
+
+	return plan
+}
+
+func (p *Plan) add(change ResourceChange) {
+	p.Changes = append(p.Changes, change)
+	switch change.Action {
+	case ActionCreate:
+		p.Creates++
+	case ActionUpdate:
+		p.Updates++
+	case ActionDelete:
+		p.Deletes++
+	}
+}
+
+// normalizeServiceType maps the legacy redis type to keyvalue, which is what live instances export as
+func normalizeServiceType(t string) string {
+	if t == ServiceTypeRedis {
+		return ServiceTypeKeyValue
+	}
+	return t
+}
+
+// withDefaultInstances sets the instance count of a live service that export leaves out because it is the default of 1
+func withDefaultInstances(svc ServiceSpec) ServiceSpec {
+	if svc.NumInstances == 0 && svc.Scaling == nil && svc.Type != ServiceTypeCron {
+		svc.NumInstances = 1
+	}
+	return svc
+}
+
+func withoutEnvVars(svc ServiceSpec) ServiceSpec {
+	svc.EnvVars = nil
+	return svc
+}
+
+// diffFields compares the fields set in desired with the same fields in live. Lists are compared as a whole, so
+// items only present in live are reported as removed.
+func diffFields(desired, live any) []FieldChange {
+	desiredFields, liveFields := flatten(desired), flatten(live)
+
+	var lists []string
+	for field, value := range desiredFields {
+		if value == "[]" {
+			lists = append(lists, field)
+		} else if i := strings.Index(field, "["); i > 0 && !slices.Contains(lists, field[:i]) {
+			lists = append(lists, field[:i])
+		}
+	}
+
+	var changes []FieldChange
+	for _, field := range sortedKeys(desiredFields) {
+		want := desiredFields[field]
+		got, ok := liveFields[field]
+		switch {
+		case !ok && want == "[]":
+			// items of the live list, if any, are reported as removed below
+		case !ok:
+			changes = append(changes, FieldChange{Action: ActionCreate, Field: field, New: want})
+		case got != want:
+			changes = append(changes, FieldChange{Action: ActionUpdate, Field: field, Old: got, New: want})
+		}
+	}
+	for _, field := range sortedKeys(liveFields) {
+		if _, ok := desiredFields[field]; ok {
+			continue
+		}
+		for _, list := range lists {
+			if strings.HasPrefix(field, list+"[") {
+				changes = append(changes, FieldChange{Action: ActionDelete, Field: field, Old: liveFields[field]})
+				break
+			}
+		}
+	}
+	return changes
+}
+
+// diffEnvVars compares env vars by key. Env vars only set in live are kept by a Blueprint sync, so they aren't
+// reported. Values are only compared for literal values, since synced, generated and referenced values can't be
+// known from the file.
+func diffEnvVars(desired, live []EnvVarSpec) []FieldChange {
+	liveByKey := map[string]EnvVarSpec{}
+	var liveGroups []string
+	for _, ev := range live {
+		if ev.FromGroup != "" {
+			liveGroups = append(liveGroups, ev.FromGroup)
+			continue
+		}
+		liveByKey[ev.Key] = ev
+	}
+
+	var changes []FieldChange
+	for _, ev := range desired {
+		if ev.FromGroup != "" {
+			if !slices.Contains(liveGroups, ev.FromGroup) {
+				changes = append(changes, FieldChange{Action: ActionCreate, Field: "envVars.fromGroup", New: ev.FromGroup})
+			}
+			continue
+		}
+
+		field := "envVars." + ev.Key
+		current, ok := liveByKey[ev.Key]
+		switch {
+		case !ok:
+			changes = append(changes, FieldChange{Action: ActionCreate, Field: field})
+		case ev.Value != "" && current.Sync == nil && current.Value != ev.Value:
+			changes = append(changes, FieldChange{Action: ActionUpdate, Field: field, Old: "(sensitive)", New: "(sensitive)"})
+		}
+	}
+	return changes
+}
+
+// flatten converts a spec to dotted field paths like disk.sizeGB or routes[0].source, using the same names as
+// render.yaml
+func flatten(v any) map[string]string {
+	fields := map[string]string{}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fields
+	}
+
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		switch val := v.(type) {
+		case map[string]any:
+			for k, child := range val {
+				walk(joinPath(prefix, k), child)
+			}
+		case []any:
+			if len(val) == 0 {
+				fields[prefix] = "[]"
+			}
+			for i, child := range val {
+				walk(indexPath(prefix, i), child)
+			}
+		default:
+			fields[prefix] = fmt.Sprint(val)
+		}
+	}
+	walk("", raw)
+	return fields
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+package blueprint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func TestDiff(t *testing.T) {
+	desired, err := blueprint.ParseSpec([]byte(`
+services:
+  - type: web
+    name: api
+    runtime: node
+    plan: standard
+    domains: [example.com]
+    envVars:
+      - key: NODE_ENV
+        value: production
+      - key: STRIPE_KEY
+        sync: false
+      - key: NEW
+        value: x
+      - fromGroup: shared
+  - type: redis
+    name: cache
+    ipAllowList: []
+  - type: worker
+    name: jobs
+    runtime: go
+databases:
+  - name: db
+    postgresMajorVersion: 16
+`))
+	require.NoError(t, err)
+
+	live := &blueprint.Spec{
+		Services: []blueprint.ServiceSpec{
+			{
+				Type: "web", Name: "api", Runtime: "node", Plan: "starter", Region: "oregon",
+				Domains: []string{"example.com", "www.example.org"},
+				EnvVars: []blueprint.EnvVarSpec{
+					{Key: "NODE_ENV", Value: "development"},
+					{Key: "STRIPE_KEY", Value: "sk_live"},
+					{Key: "EXTRA", Value: "kept"},
+				},
+			},
+			{Type: "keyvalue", Name: "cache", IPAllowList: &[]blueprint.IPAllowSpec{}},
+			{Type: "web", Name: "legacy", Runtime: "ruby"},
+		},
+		Databases: []blueprint.DatabaseSpec{{Name: "db", PostgresMajorVersion: "16", Plan: "basic-1gb"}},
+	}
+
+	plan := blueprint.Diff(desired, live, true)
+	assert.True(t, plan.HasDrift())
+	assert.Equal(t, 1, plan.Creates)
+	assert.Equal(t, 1, plan.Updates)
+	assert.Equal(t, 1, plan.Deletes)
+
+	require.Len(t, plan.Changes, 3)
+	api := plan.Changes[0]
+	assert.Equal(t, blueprint.ActionUpdate, api.Action)
+	assert.Equal(t, []blueprint.FieldChange{
+		{Action: blueprint.ActionUpdate, Field: "plan", Old: "starter", New: "standard"},
+		{Action: blueprint.ActionDelete, Field: "domains[1]", Old: "www.example.org"},
+		{Action: blueprint.ActionUpdate, Field: "envVars.NODE_ENV", Old: "(sensitive)", New: "(sensitive)"},
+		{Action: blueprint.ActionCreate, Field: "envVars.NEW"},
+		{Action: blueprint.ActionCreate, Field: "envVars.fromGroup", New: "shared"},
+	}, api.Changes)
+
+	assert.Equal(t, blueprint.ResourceChange{Action: blueprint.ActionCreate, Kind: blueprint.KindService, Name: "jobs", Type: "worker"}, plan.Changes[1])
+	assert.Equal(t, blueprint.ResourceChange{Action: blueprint.ActionDelete, Kind: blueprint.KindService, Name: "legacy", Type: "web"}, plan.Changes[2])
+
+	withoutDeletes := blueprint.Diff(desired, live, false)
+	assert.Equal(t, 0, withoutDeletes.Deletes)
+}
+
+func TestDiffNoDrift(t *testing.T) {
+	spec := &blueprint.Spec{
+		Services: []blueprint.ServiceSpec{{Type: "web", Name: "api", Runtime: "node", AutoDeploy: pointers.From(false)}},
+	}
+	plan := blueprint.Diff(spec, spec, true)
+	assert.False(t, plan.HasDrift())
+	assert.Contains(t, blueprint.PlanSummary(plan), "No changes")
+}
+
+func TestDiffDefaultInstances(t *testing.T) {
+	desired := &blueprint.Spec{
+		Services: []blueprint.ServiceSpec{
+			{Type: "web", Name: "api", Runtime: "node", NumInstances: 1},
+			{Type: "worker", Name: "jobs", Runtime: "go", NumInstances: 2},
+		},
+	}
+	// export leaves out numInstances for services with a single instance
+	live := &blueprint.Spec{
+		Services: []blueprint.ServiceSpec{
+			{Type: "web", Name: "api", Runtime: "node"},
+			{Type: "worker", Name: "jobs", Runtime: "go"},
+		},
+	}
+
+	plan := blueprint.Diff(desired, live, true)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, "jobs", plan.Changes[0].Name)
+	assert.Equal(t, []blueprint.FieldChange{
+		{Action: blueprint.ActionUpdate, Field: "numInstances", Old: "1", New: "2"},
+	}, plan.Changes[0].Changes)
+}
+
+func TestDiffProjects(t *testing.T) {
+	desired, err := blueprint.ParseSpec([]byte(`
+services:
+  - type: web
+    name: site
+    runtime: static
+projects:
+  - name: shop
+    environments:
+      - name: production
+        services:
+          - type: web
+            name: api
+            runtime: node
+        databases:
+          - name: db
+      - name: staging
+        envVarGroups:
+          - name: shared
+`))
+	require.NoError(t, err)
+
+	live := &blueprint.Spec{
+		Services: []blueprint.ServiceSpec{
+			{Type: "web", Name: "site", Runtime: "static"},
+			{Type: "web", Name: "api", Runtime: "node"},
+		},
+		Databases:    []blueprint.DatabaseSpec{{Name: "db"}},
+		EnvVarGroups: []blueprint.EnvVarGroupSpec{{Name: "shared"}},
+	}
+
+	plan := blueprint.Diff(desired, live, true)
+	assert.False(t, plan.HasDrift())
+}
@@ -0,0 +1,116 @@
+package blueprint
+
+import (
+	"context"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+func (r *Repo) ListBlueprints(ctx context.Context, params *client.ListBlueprintsParams) ([]*clientblueprints.Blueprint, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+func (r *Repo) listPage(ctx context.Context, params *client.ListBlueprintsParams) ([]*clientblueprints.Blueprint, *client.Cursor, error) {
+	resp, err := r.client.ListBlueprintsWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	blueprints := make([]*clientblueprints.Blueprint, 0, len(res))
+	for _, blueprintWithCursor := range res {
+		blueprints = append(blueprints, &blueprintWithCursor.Blueprint)
+	}
+
+	return blueprints, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) GetBlueprint(ctx context.Context, id string) (*clientblueprints.BlueprintDetail, error) {
+	resp, err := r.client.RetrieveBlueprintWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UpdateBlueprint(ctx context.Context, id string, data clientblueprints.BlueprintPATCH) (*clientblueprints.Blueprint, error) {
+	resp, err := r.client.UpdateBlueprintWithResponse(ctx, id, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// DisconnectBlueprint stops Render from managing resources with the Blueprint. The resources themselves are kept.
+func (r *Repo) DisconnectBlueprint(ctx context.Context, id string) error {
+	resp, err := r.client.DisconnectBlueprintWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) ListSyncs(ctx context.Context, id string, params *client.ListBlueprintSyncsParams) ([]*clientblueprints.Sync, error) {
+	return client.ListAll(ctx, params, func(ctx context.Context, params *client.ListBlueprintSyncsParams) ([]*clientblueprints.Sync, *client.Cursor, error) {
+		return r.listSyncsPage(ctx, id, params)
+	})
+}
+
+func (r *Repo) listSyncsPage(ctx context.Context, id string, params *client.ListBlueprintSyncsParams) ([]*clientblueprints.Sync, *client.Cursor, error) {
+	resp, err := r.client.ListBlueprintSyncsWithResponse(ctx, id, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	syncs := make([]*clientblueprints.Sync, 0, len(res))
+	for _, syncWithCursor := range res {
+		syncs = append(syncs, &syncWithCursor.Sync)
+	}
+
+	return syncs, &res[len(res)-1].Cursor, nil
+}
@@ -0,0 +1,263 @@
+package blueprint
+
+import "gopkg.in/yaml.v3"
+
+// Values accepted in a render.yaml Blueprint. See https://render.com/docs/blueprint-spec
+
+const (
+	ServiceTypeWeb      = "web"
+	ServiceTypePserv    = "pserv"
+	ServiceTypeWorker   = "worker"
+	ServiceTypeCron     = "cron"
+	ServiceTypeKeyValue = "keyvalue"
+	ServiceTypeRedis    = "redis"
+
+	RuntimeStatic = "static"
+	RuntimeDocker = "docker"
+	RuntimeImage  = "image"
+)
+
+var ServiceTypes = []string{
+	ServiceTypeWeb, ServiceTypePserv, ServiceTypeWorker, ServiceTypeCron, ServiceTypeKeyValue, ServiceTypeRedis,
+}
+
+var Runtimes = []string{
+	"node", "python", "elixir", "go", "ruby", "rust", RuntimeDocker, RuntimeImage, RuntimeStatic,
+}
+
+// planName pairs a plan name from the API, e.g. pro_plus, with the name accepted in render.yaml, e.g. "pro plus".
+// Export and Validate both read these tables so exported files always validate.
+type planName struct {
+	api       string
+	blueprint string
+}
+
+var servicePlanNames = []planName{
+	{"free", "free"}, {"starter", "starter"}, {"starter_plus", "starter plus"}, {"standard", "standard"},
+	{"standard_plus", "standard plus"}, {"pro", "pro"}, {"pro_plus", "pro plus"}, {"pro_max", "pro max"},
+	{"pro_ultra", "pro ultra"},
+}
+
+var databasePlanNames = []planName{
+	// legacy instance types
+	{"free", "free"}, {"starter", "starter"}, {"standard", "standard"}, {"pro", "pro"}, {"pro_plus", "pro plus"},
+	{"basic_256mb", "basic-256mb"}, {"basic_1gb", "basic-1gb"}, {"basic_4gb", "basic-4gb"},
+	{"pro_4gb", "pro-4gb"}, {"pro_8gb", "pro-8gb"}, {"pro_16gb", "pro-16gb"}, {"pro_32gb", "pro-32gb"},
+	{"pro_64gb", "pro-64gb"}, {"pro_128gb", "pro-128gb"}, {"pro_192gb", "pro-192gb"}, {"pro_256gb", "pro-256gb"},
+	{"pro_384gb", "pro-384gb"}, {"pro_512gb", "pro-512gb"},
+	{"accelerated_16gb", "accelerated-16gb"}, {"accelerated_32gb", "accelerated-32gb"},
+	{"accelerated_64gb", "accelerated-64gb"}, {"accelerated_128gb", "accelerated-128gb"},
+	{"accelerated_256gb", "accelerated-256gb"}, {"accelerated_384gb", "accelerated-384gb"},
+	{"accelerated_512gb", "accelerated-512gb"}, {"accelerated_768gb", "accelerated-768gb"},
+	{"accelerated_1024gb", "accelerated-1024gb"},
+}
+
+var ServicePlans = blueprintPlanNames(servicePlanNames)
+
+var DatabasePlans = blueprintPlanNames(databasePlanNames)
+
+func blueprintPlanNames(names []planName) []string {
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		res = append(res, name.blueprint)
+	}
+	return res
+}
+
+// blueprintPlan returns the render.yaml name of an API plan. Plans that can't be set in render.yaml, such as custom
+// plans, are returned unchanged so validation points them out.
+func blueprintPlan(names []planName, plan string) string {
+	for _, name := range names {
+		if name.api == plan {
+			return name.blueprint
+		}
+	}
+	return plan
+}
+
+var Regions = []string{"oregon", "ohio", "virginia", "frankfurt", "singapore"}
+
+var FromServiceProperties = []string{"host", "port", "hostport", "connectionString"}
+
+var FromDatabaseProperties = []string{"host", "port", "database", "user", "password", "connectionString"}
+
+var topLevelKeys = []string{
+	"services", "databases", "envVarGroups", "projects", "previews", "previewsEnabled", "previewsExpireAfterDays", "version",
+}
+
+var projectKeys = []string{"name", "environments"}
+
+var environmentKeys = []string{
+	"name", "services", "databases", "envVarGroups", "networkIsolationEnabled", "protectionEnabled",
+}
+
+var serviceKeys = []string{
+	"type", "name", "runtime", "env", "plan", "region", "repo", "branch", "rootDir",
+	"buildCommand", "startCommand", "preDeployCommand", "schedule", "healthCheckPath",
+	"numInstances", "scaling", "autoDeploy", "autoDeployTrigger", "buildFilter",
+	"dockerfilePath", "dockerContext", "dockerCommand", "registryCredential", "image",
+	"envVars", "disk", "domains", "staticPublishPath", "headers", "routes",
+	"pullRequestPreviewsEnabled", "previews", "previewPlan", "ipAllowList", "maxmemoryPolicy",
+	"maxShutdownDelaySeconds", "initialDeployHook",
+}
+
+var databaseKeys = []string{
+	"name", "databaseName", "user", "plan", "previewPlan", "region", "postgresMajorVersion",
+	"ipAllowList", "readReplicas", "highAvailability", "diskSizeGB",
+}
+
+var envVarGroupKeys = []string{"name", "envVars"}
+
+var envVarKeys = []string{"key", "value", "generateValue", "sync", "fromService", "fromDatabase", "fromGroup", "previewValue"}
+
+// Spec is the subset of a render.yaml Blueprint the CLI reads and writes. Fields are in the order they are
+// documented so generated files read naturally.
+type Spec struct {
+	Services     []ServiceSpec     `json:"services,omitempty" yaml:"services,omitempty"`
+	Databases    []DatabaseSpec    `json:"databases,omitempty" yaml:"databases,omitempty"`
+	EnvVarGroups []EnvVarGroupSpec `json:"envVarGroups,omitempty" yaml:"envVarGroups,omitempty"`
+}
+
+type ServiceSpec struct {
+	Type              string           `json:"type" yaml:"type"`
+	Name              string           `json:"name" yaml:"name"`
+	Runtime           string           `json:"runtime,omitempty" yaml:"runtime,omitempty"`
+	Plan              string           `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Region            string           `json:"region,omitempty" yaml:"region,omitempty"`
+	Repo              string           `json:"repo,omitempty" yaml:"repo,omitempty"`
+	Branch            string           `json:"branch,omitempty" yaml:"branch,omitempty"`
+	RootDir           string           `json:"rootDir,omitempty" yaml:"rootDir,omitempty"`
+	AutoDeploy        *bool            `json:"autoDeploy,omitempty" yaml:"autoDeploy,omitempty"`
+	BuildFilter       *BuildFilterSpec `json:"buildFilter,omitempty" yaml:"buildFilter,omitempty"`
+	Image             *ImageSpec       `json:"image,omitempty" yaml:"image,omitempty"`
+	BuildCommand      string           `json:"buildCommand,omitempty" yaml:"buildCommand,omitempty"`
+	StartCommand      string           `json:"startCommand,omitempty" yaml:"startCommand,omitempty"`
+	PreDeployCommand  string           `json:"preDeployCommand,omitempty" yaml:"preDeployCommand,omitempty"`
+	DockerfilePath    string           `json:"dockerfilePath,omitempty" yaml:"dockerfilePath,omitempty"`
+	DockerContext     string           `json:"dockerContext,omitempty" yaml:"dockerContext,omitempty"`
+	DockerCommand     string           `json:"dockerCommand,omitempty" yaml:"dockerCommand,omitempty"`
+	StaticPublishPath string           `json:"staticPublishPath,omitempty" yaml:"staticPublishPath,omitempty"`
+	Schedule          string           `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	HealthCheckPath   string           `json:"healthCheckPath,omitempty" yaml:"healthCheckPath,omitempty"`
+	NumInstances      int              `json:"numInstances,omitempty" yaml:"numInstances,omitempty"`
+	Scaling           *ScalingSpec     `json:"scaling,omitempty" yaml:"scaling,omitempty"`
+	Disk              *DiskSpec        `json:"disk,omitempty" yaml:"disk,omitempty"`
+	Domains           []string         `json:"domains,omitempty" yaml:"domains,omitempty"`
+	Routes            []RouteSpec      `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Headers           []HeaderSpec     `json:"headers,omitempty" yaml:"headers,omitempty"`
+	IPAllowList       *[]IPAllowSpec   `json:"ipAllowList,omitempty" yaml:"ipAllowList,omitempty"`
+	MaxmemoryPolicy   string           `json:"maxmemoryPolicy,omitempty" yaml:"maxmemoryPolicy,omitempty"`
+	EnvVars           []EnvVarSpec     `json:"envVars,omitempty" yaml:"envVars,omitempty"`
+}
+
+type BuildFilterSpec struct {
+	Paths        []string `json:"paths,omitempty" yaml:"paths,omitempty"`
+	IgnoredPaths []string `json:"ignoredPaths,omitempty" yaml:"ignoredPaths,omitempty"`
+}
+
+type ImageSpec struct {
+	URL string `json:"url" yaml:"url"`
+}
+
+type ScalingSpec struct {
+	MinInstances        int `json:"minInstances" yaml:"minInstances"`
+	MaxInstances        int `json:"maxInstances" yaml:"maxInstances"`
+	TargetCPUPercent    int `json:"targetCPUPercent,omitempty" yaml:"targetCPUPercent,omitempty"`
+	TargetMemoryPercent int `json:"targetMemoryPercent,omitempty" yaml:"targetMemoryPercent,omitempty"`
+}
+
+type DiskSpec struct {
+	Name      string `json:"name" yaml:"name"`
+	MountPath string `json:"mountPath" yaml:"mountPath"`
+	SizeGB    int    `json:"sizeGB,omitempty" yaml:"sizeGB,omitempty"`
+}
+
+type RouteSpec struct {
+	Type        string `json:"type" yaml:"type"`
+	Source      string `json:"source" yaml:"source"`
+	Destination string `json:"destination" yaml:"destination"`
+}
+
+type HeaderSpec struct {
+	Path  string `json:"path" yaml:"path"`
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
+}
+
+type IPAllowSpec struct {
+	Source      string `json:"source" yaml:"source"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// EnvVarSpec is a single env var. Exactly one of the value fields is set, except for fromGroup entries
+// which only set FromGroup.
+type EnvVarSpec struct {
+	Key           string            `json:"key,omitempty" yaml:"key,omitempty"`
+	Value         string            `json:"value,omitempty" yaml:"value,omitempty"`
+	GenerateValue bool              `json:"generateValue,omitempty" yaml:"generateValue,omitempty"`
+	Sync          *bool             `json:"sync,omitempty" yaml:"sync,omitempty"`
+	FromService   *FromServiceSpec  `json:"fromService,omitempty" yaml:"fromService,omitempty"`
+	FromDatabase  *FromDatabaseSpec `json:"fromDatabase,omitempty" yaml:"fromDatabase,omitempty"`
+	FromGroup     string            `json:"fromGroup,omitempty" yaml:"fromGroup,omitempty"`
+}
+
+type FromServiceSpec struct {
+	Name      string `json:"name" yaml:"name"`
+	Type      string `json:"type" yaml:"type"`
+	Property  string `json:"property,omitempty" yaml:"property,omitempty"`
+	EnvVarKey string `json:"envVarKey,omitempty" yaml:"envVarKey,omitempty"`
+}
+
+type FromDatabaseSpec struct {
+	Name     string `json:"name" yaml:"name"`
+	Property string `json:"property" yaml:"property"`
+}
+
+type DatabaseSpec struct {
+	Name                 string        `json:"name" yaml:"name"`
+	DatabaseName         string        `json:"databaseName,omitempty" yaml:"databaseName,omitempty"`
+	User                 string        `json:"user,omitempty" yaml:"user,omitempty"`
+	Plan                 string        `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Region               string        `json:"region,omitempty" yaml:"region,omitempty"`
+	PostgresMajorVersion string        `json:"postgresMajorVersion,omitempty" yaml:"postgresMajorVersion,omitempty"`
+	DiskSizeGB           int           `json:"diskSizeGB,omitempty" yaml:"diskSizeGB,omitempty"`
+	HighAvailability     *HASpec       `json:"highAvailability,omitempty" yaml:"highAvailability,omitempty"`
+	IPAllowList          []IPAllowSpec `json:"ipAllowList,omitempty" yaml:"ipAllowList,omitempty"`
+}
+
+type HASpec struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+type EnvVarGroupSpec struct {
+	Name    string       `json:"name" yaml:"name"`
+	EnvVars []EnvVarSpec `json:"envVars,omitempty" yaml:"envVars,omitempty"`
+}
+
+// fileSpec is a render.yaml file as written. Project environments list their resources with the same keys as the
+// top level of the file.
+type fileSpec struct {
+	Spec     `yaml:",inline"`
+	Projects []struct {
+		Environments []Spec `yaml:"environments"`
+	} `yaml:"projects"`
+}
+
+// ParseSpec reads a render.yaml file. Keys the CLI doesn't model are ignored, use Validate to report mistakes.
+// Resources of project environments are added to the top-level lists, since resources are matched by name.
+func ParseSpec(data []byte) (*Spec, error) {
+	var file fileSpec
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	spec := file.Spec
+	for _, project := range file.Projects {
+		for _, env := range project.Environments {
+			spec.Services = append(spec.Services, env.Services...)
+			spec.Databases = append(spec.Databases, env.Databases...)
+			spec.EnvVarGroups = append(spec.EnvVarGroups, env.EnvVarGroups...)
+		}
+	}
+	return &spec, nil
+}
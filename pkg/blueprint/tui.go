@@ -0,0 +1,138 @@
+package blueprint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func Header() []string {
+	return []string{"Name", "Status", "Repo", "Branch", "Auto Sync", "Last Sync", "ID"}
+}
+
+func Row(b *clientblueprints.Blueprint) []string {
+	return []string{
+		b.Name,
+		string(b.Status),
+		b.Repo,
+		b.Branch,
+		fmt.Sprintf("%t", b.AutoSync),
+		pointers.TimeValue(b.LastSync),
+		b.Id,
+	}
+}
+
+func ResourceHeader() []string {
+	return []string{"Name", "Type", "ID"}
+}
+
+func ResourceRow(r clientblueprints.ResourceRef) []string {
+	return []string{r.Name, string(r.Type), r.Id}
+}
+
+func SyncHeader() []string {
+	return []string{"Commit", "State", "Started", "Completed", "Duration", "ID"}
+}
+
+func SyncRow(s *clientblueprints.Sync) []string {
+	return []string{
+		ShortCommit(s.Commit.Id),
+		string(s.State),
+		pointers.TimeValue(s.StartedAt),
+		pointers.TimeValue(s.CompletedAt),
+		SyncDuration(s),
+		s.Id,
+	}
+}
+
+// ShortCommit abbreviates a commit SHA the way git does
+func ShortCommit(id string) string {
+	if len(id) > 7 {
+		return id[:7]
+	}
+	return id
+}
+
+// SyncDuration returns how long a sync took, or has been running for if it hasn't completed
+func SyncDuration(s *clientblueprints.Sync) string {
+	if s.StartedAt == nil {
+		return ""
+	}
+	end := time.Now()
+	if s.CompletedAt != nil {
+		end = *s.CompletedAt
+	}
+	return end.Sub(*s.StartedAt).Round(time.Second).String()
+}
+
+// Details formats all the fields of a blueprint as aligned key value lines
+func Details(b *clientblueprints.BlueprintDetail) string {
+	lines := []string{
+		fmt.Sprintf("%-11s %s", "ID:", b.Id),
+		fmt.Sprintf("%-11s %s", "Name:", b.Name),
+		fmt.Sprintf("%-11s %s", "Status:", b.Status),
+		fmt.Sprintf("%-11s %s", "Repo:", b.Repo),
+		fmt.Sprintf("%-11s %s", "Branch:", b.Branch),
+		fmt.Sprintf("%-11s %t", "Auto Sync:", b.AutoSync),
+		fmt.Sprintf("%-11s %s", "Last Sync:", pointers.TimeValue(b.LastSync)),
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ValidationSummary formats validation issues the way compilers do, one "file:line:column: severity: message"
+// line per issue followed by a count
+func ValidationSummary(res *Result) string {
+	if len(res.Issues) == 0 {
+		return fmt.Sprintf("%s is valid", res.File)
+	}
+
+	lines := make([]string, 0, len(res.Issues)+2)
+	for _, issue := range res.Issues {
+		lines = append(lines, fmt.Sprintf("%s:%d:%d: %s: %s", res.File, issue.Line, issue.Column, issue.Severity, issue.Message))
+	}
+	lines = append(lines, "", fmt.Sprintf("%d error(s), %d warning(s)", res.Errors, res.Warnings))
+	return strings.Join(lines, "\n")
+}
+
+var planSymbols = map[string]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+}
+
+// PlanSummary formats a plan like Terraform, one line per resource followed by its changed fields
+func PlanSummary(p *Plan) string {
+	if !p.HasDrift() {
+		return fmt.Sprintf("No changes. Live resources match %s.", p.File)
+	}
+
+	var lines []string
+	for _, change := range p.Changes {
+		line := fmt.Sprintf("%s %s %q", planSymbols[change.Action], change.Kind, change.Name)
+		if change.Type != "" {
+			line += fmt.Sprintf(" (%s)", change.Type)
+		}
+		lines = append(lines, line)
+
+		for _, field := range change.Changes {
+			switch field.Action {
+			case ActionCreate:
+				line = fmt.Sprintf("    + %s", field.Field)
+				if field.New != "" {
+					line += fmt.Sprintf(": %q", field.New)
+				}
+			case ActionUpdate:
+				line = fmt.Sprintf("    ~ %s: %q -> %q", field.Field, field.Old, field.New)
+			case ActionDelete:
+				line = fmt.Sprintf("    - %s: %q", field.Field, field.Old)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	lines = append(lines, "", fmt.Sprintf("Plan: %d to create, %d to update, %d to delete.", p.Creates, p.Updates, p.Deletes))
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,543 @@
+package blueprint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Issue is a problem found in a render.yaml file, located by line and column
+type Issue struct {
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Path     string `json:"path,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Result is the outcome of validating a single file
+type Result struct {
+	File     string  `json:"file"`
+	Valid    bool    `json:"valid"`
+	Errors   int     `json:"errors"`
+	Warnings int     `json:"warnings"`
+	Issues   []Issue `json:"issues"`
+}
+
+var yamlLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// Validate checks a render.yaml file without calling the API. Errors would cause the Blueprint sync to fail,
+// warnings are keys or values that are likely mistakes but are not rejected.
+func Validate(file string, data []byte) *Result {
+	v := &validator{
+		services:     map[string]*yaml.Node{},
+		databases:    map[string]*yaml.Node{},
+		envVarGroups: map[string]*yaml.Node{},
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		v.syntaxError(err)
+	} else if len(doc.Content) == 0 {
+		v.issues = append(v.issues, Issue{Line: 1, Column: 1, Severity: SeverityError, Message: "file is empty"})
+	} else {
+		v.validateRoot(doc.Content[0])
+	}
+
+	res := &Result{File: file, Issues: v.issues}
+	if res.Issues == nil {
+		res.Issues = []Issue{}
+	}
+	slices.SortStableFunc(res.Issues, func(a, b Issue) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	for _, issue := range res.Issues {
+		if issue.Severity == SeverityError {
+			res.Errors++
+		} else {
+			res.Warnings++
+		}
+	}
+	res.Valid = res.Errors == 0
+	return res
+}
+
+// envVarRef is a fromService, fromDatabase or fromGroup reference, checked once all names are known
+type envVarRef struct {
+	node    *yaml.Node
+	path    string
+	kind    string
+	name    string
+	refType string
+}
+
+type validator struct {
+	issues []Issue
+
+	services     map[string]*yaml.Node
+	serviceTypes map[string]string
+	databases    map[string]*yaml.Node
+	envVarGroups map[string]*yaml.Node
+	refs         []envVarRef
+}
+
+func (v *validator) errorf(n *yaml.Node, p string, format string, a ...any) {
+	v.issues = append(v.issues, Issue{Line: n.Line, Column: n.Column, Severity: SeverityError, Path: p, Message: fmt.Sprintf(format, a...)})
+}
+
+func (v *validator) warnf(n *yaml.Node, p string, format string, a ...any) {
+	v.issues = append(v.issues, Issue{Line: n.Line, Column: n.Column, Severity: SeverityWarning, Path: p, Message: fmt.Sprintf(format, a...)})
+}
+
+func (v *validator) syntaxError(err error) {
+	line := 1
+	msg := strings.TrimPrefix(err.Error(), "yaml: ")
+	if m := yamlLineRegex.FindStringSubmatch(msg); m != nil {
+		line, _ = strconv.Atoi(m[1])
+		msg = strings.TrimPrefix(msg, m[0]+": ")
+	}
+	v.issues = append(v.issues, Issue{Line: line, Column: 1, Severity: SeverityError, Message: msg})
+}
+
+func (v *validator) validateRoot(root *yaml.Node) {
+	if root.Kind != yaml.MappingNode {
+		v.errorf(root, "", "expected a mapping with a services key")
+		return
+	}
+
+	v.checkKeys(root, "", topLevelKeys)
+
+	var services, databases, groups []located
+	v.collectResources(root, "", &services, &databases, &groups)
+	for i, project := range v.sequence(root, "", "projects") {
+		v.collectProject(project, indexPath("projects", i), &services, &databases, &groups)
+	}
+	if len(services) == 0 && len(databases) == 0 && len(groups) == 0 {
+		v.errorf(root, "", "file does not define any services, databases or envVarGroups")
+	}
+
+	// collect names first so references can point at resources defined later in the file
+	v.serviceTypes = map[string]string{}
+	for _, svc := range services {
+		v.collectName(svc.node, svc.path, v.services, "service")
+		if name, typ := scalar(svc.node, "name"), scalar(svc.node, "type"); name != nil && typ != nil {
+			if _, ok := v.serviceTypes[name.Value]; !ok {
+				v.serviceTypes[name.Value] = typ.Value
+			}
+		}
+	}
+	for _, db := range databases {
+		v.collectName(db.node, db.path, v.databases, "database")
+	}
+	for _, group := range groups {
+		v.collectName(group.node, group.path, v.envVarGroups, "envVarGroup")
+	}
+
+	for _, svc := range services {
+		v.validateService(svc.node, svc.path)
+	}
+	for _, db := range databases {
+		v.validateDatabase(db.node, db.path)
+	}
+	for _, group := range groups {
+		v.validateEnvVarGroup(group.node, group.path)
+	}
+
+	v.validateRefs()
+}
+
+// located is a resource node along with its path in the file
+type located struct {
+	node *yaml.Node
+	path string
+}
+
+// collectResources adds the services, databases and envVarGroups defined directly under n, either the top level of
+// the file or a project environment
+func (v *validator) collectResources(n *yaml.Node, p string, services, databases, groups *[]located) {
+	for _, section := range []struct {
+		key  string
+		list *[]located
+	}{{"services", services}, {"databases", databases}, {"envVarGroups", groups}} {
+		for i, item := range v.sequence(n, p, section.key) {
+			*section.list = append(*section.list, located{node: item, path: indexPath(joinPath(p, section.key), i)})
+		}
+	}
+}
+
+func (v *validator) collectProject(project *yaml.Node, p string, services, databases, groups *[]located) {
+	if project.Kind != yaml.MappingNode {
+		v.errorf(project, p, "expected a project mapping")
+		return
+	}
+
+	v.checkKeys(project, p, projectKeys)
+	v.requireString(project, p, "name")
+
+	environments := v.sequence(project, p, "environments")
+	if environments == nil {
+		v.errorf(project, p, "project must define at least one environment")
+	}
+	for i, env := range environments {
+		ep := indexPath(p+".environments", i)
+		if env.Kind != yaml.MappingNode {
+			v.errorf(env, ep, "expected an environment mapping")
+			continue
+		}
+		v.checkKeys(env, ep, environmentKeys)
+		v.requireString(env, ep, "name")
+		v.collectResources(env, ep, services, databases, groups)
+	}
+}
+
+func (v *validator) collectName(n *yaml.Node, p string, seen map[string]*yaml.Node, kind string) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	name := scalar(n, "name")
+	if name == nil || name.Value == "" {
+		return
+	}
+	if first, ok := seen[name.Value]; ok {
+		v.errorf(name, joinPath(p, "name"), "duplicate %s name %q, first defined on line %d", kind, name.Value, first.Line)
+		return
+	}
+	seen[name.Value] = name
+}
+
+func (v *validator) validateService(svc *yaml.Node, p string) {
+	if svc.Kind != yaml.MappingNode {
+		v.errorf(svc, p, "expected a service mapping")
+		return
+	}
+
+	v.checkKeys(svc, p, serviceKeys)
+	v.requireString(svc, p, "name")
+
+	typ := v.requireString(svc, p, "type")
+	if typ != nil {
+		switch {
+		case typ.Value == RuntimeStatic:
+			v.errorf(typ, p+".type", "invalid service type %q, static sites use type: web with runtime: static", typ.Value)
+		case !slices.Contains(ServiceTypes, typ.Value):
+			v.errorf(typ, p+".type", "invalid service type %q, expected one of %s", typ.Value, strings.Join(ServiceTypes, ", "))
+		}
+	}
+	serviceType := ""
+	if typ != nil {
+		serviceType = typ.Value
+	}
+	isKeyValue := serviceType == ServiceTypeKeyValue || serviceType == ServiceTypeRedis
+
+	runtime := scalar(svc, "runtime")
+	runtimeKey := "runtime"
+	if runtime == nil {
+		runtime = scalar(svc, "env")
+		runtimeKey = "env"
+	}
+	switch {
+	case runtime != nil && !slices.Contains(Runtimes, runtime.Value):
+		v.errorf(runtime, p+"."+runtimeKey, "invalid runtime %q, expected one of %s", runtime.Value, strings.Join(Runtimes, ", "))
+	case runtime == nil && typ != nil && !isKeyValue:
+		v.errorf(svc, p, "service is missing runtime")
+	}
+
+	if plan := scalar(svc, "plan"); plan != nil {
+		switch {
+		case !slices.Contains(ServicePlans, plan.Value):
+			v.errorf(plan, p+".plan", "invalid plan %q, expected one of %s", plan.Value, strings.Join(ServicePlans, ", "))
+		case plan.Value == "free" && slices.Contains([]string{ServiceTypePserv, ServiceTypeWorker, ServiceTypeCron}, serviceType):
+			v.errorf(plan, p+".plan", "the free plan is not available for %s services", serviceType)
+		}
+	}
+
+	v.checkRegion(svc, p)
+
+	schedule := scalar(svc, "schedule")
+	switch {
+	case serviceType == ServiceTypeCron && schedule == nil:
+		v.errorf(svc, p, "cron jobs require a schedule")
+	case serviceType == ServiceTypeCron:
+		if err := ValidateCronSchedule(schedule.Value); err != nil {
+			v.errorf(schedule, p+".schedule", "invalid cron schedule %q: %s", schedule.Value, err)
+		}
+	case schedule != nil && typ != nil:
+		v.warnf(schedule, p+".schedule", "schedule is ignored for %s services", serviceType)
+	}
+
+	if isKeyValue && value(svc, "ipAllowList") == nil {
+		v.errorf(svc, p, "%s services require an ipAllowList", serviceType)
+	}
+
+	if disk := value(svc, "disk"); disk != nil {
+		v.validateDisk(disk, p+".disk")
+	}
+
+	v.validateEnvVars(svc, p, true)
+}
+
+func (v *validator) validateDisk(disk *yaml.Node, p string) {
+	if disk.Kind != yaml.MappingNode {
+		v.errorf(disk, p, "expected a disk mapping")
+		return
+	}
+	v.requireString(disk, p, "name")
+	if mountPath := v.requireString(disk, p, "mountPath"); mountPath != nil && !strings.HasPrefix(mountPath.Value, "/") {
+		v.errorf(mountPath, p+".mountPath", "mountPath %q must be an absolute path", mountPath.Value)
+	}
+	if size := scalar(disk, "sizeGB"); size != nil {
+		if n, err := strconv.Atoi(size.Value); err != nil || n < 1 {
+			v.errorf(size, p+".sizeGB", "sizeGB must be a whole number of at least 1")
+		}
+	}
+}
+
+func (v *validator) validateDatabase(db *yaml.Node, p string) {
+	if db.Kind != yaml.MappingNode {
+		v.errorf(db, p, "expected a database mapping")
+		return
+	}
+
+	v.checkKeys(db, p, databaseKeys)
+	v.requireString(db, p, "name")
+	if plan := scalar(db, "plan"); plan != nil && !slices.Contains(DatabasePlans, plan.Value) {
+		v.errorf(plan, p+".plan", "invalid database plan %q", plan.Value)
+	}
+	v.checkRegion(db, p)
+}
+
+func (v *validator) validateEnvVarGroup(group *yaml.Node, p string) {
+	if group.Kind != yaml.MappingNode {
+		v.errorf(group, p, "expected an envVarGroup mapping")
+		return
+	}
+
+	v.checkKeys(group, p, envVarGroupKeys)
+	v.requireString(group, p, "name")
+	v.validateEnvVars(group, p, false)
+}
+
+// validateEnvVars checks the envVars of a service or an env group. Env groups can't reference other resources.
+func (v *validator) validateEnvVars(parent *yaml.Node, p string, allowRefs bool) {
+	seen := map[string]*yaml.Node{}
+
+	for i, envVar := range v.sequence(parent, p, "envVars") {
+		ep := indexPath(p+".envVars", i)
+		if envVar.Kind != yaml.MappingNode {
+			v.errorf(envVar, ep, "expected an env var mapping")
+			continue
+		}
+		v.checkKeys(envVar, ep, envVarKeys)
+
+		if group := value(envVar, "fromGroup"); group != nil {
+			if !allowRefs {
+				v.errorf(group, ep+".fromGroup", "env groups can't reference other env groups")
+			} else if group.Kind != yaml.ScalarNode || group.Value == "" {
+				v.errorf(group, ep+".fromGroup", "fromGroup must be the name of an envVarGroup")
+			} else {
+				v.refs = append(v.refs, envVarRef{node: group, path: ep + ".fromGroup", kind: "envVarGroup", name: group.Value})
+			}
+			continue
+		}
+
+		key := v.requireString(envVar, ep, "key")
+		if key != nil {
+			if first, ok := seen[key.Value]; ok {
+				v.errorf(key, ep+".key", "duplicate env var %q, first defined on line %d", key.Value, first.Line)
+			} else {
+				seen[key.Value] = key
+			}
+		}
+
+		var sources []string
+		for _, k := range []string{"value", "generateValue", "fromService", "fromDatabase"} {
+			if value(envVar, k) != nil {
+				sources = append(sources, k)
+			}
+		}
+		if sync := scalar(envVar, "sync"); sync != nil {
+			if sync.Value != "false" {
+				v.errorf(sync, ep+".sync", "sync only accepts false")
+			}
+			sources = append(sources, "sync")
+		}
+
+		switch {
+		case len(sources) == 0:
+			v.errorf(envVar, ep, "env var must set one of value, generateValue, sync: false, fromService or fromDatabase")
+		case len(sources) > 1:
+			v.errorf(envVar, ep, "env var sets more than one of %s", strings.Join(sources, ", "))
+		}
+
+		if svc := value(envVar, "fromService"); svc != nil {
+			if !allowRefs {
+				v.errorf(svc, ep+".fromService", "env groups can't reference services")
+			} else {
+				v.validateFromService(svc, ep+".fromService")
+			}
+		}
+		if db := value(envVar, "fromDatabase"); db != nil {
+			if !allowRefs {
+				v.errorf(db, ep+".fromDatabase", "env groups can't reference databases")
+			} else {
+				v.validateFromDatabase(db, ep+".fromDatabase")
+			}
+		}
+	}
+}
+
+func (v *validator) validateFromService(ref *yaml.Node, p string) {
+	if ref.Kind != yaml.MappingNode {
+		v.errorf(ref, p, "expected a mapping with name, type and property or envVarKey")
+		return
+	}
+
+	name := v.requireString(ref, p, "name")
+	typ := v.requireString(ref, p, "type")
+	if typ != nil && !slices.Contains(ServiceTypes, typ.Value) {
+		v.errorf(typ, p+".type", "invalid service type %q, expected one of %s", typ.Value, strings.Join(ServiceTypes, ", "))
+		typ = nil
+	}
+
+	property, envVarKey := scalar(ref, "property"), scalar(ref, "envVarKey")
+	switch {
+	case property == nil && envVarKey == nil:
+		v.errorf(ref, p, "fromService must set property or envVarKey")
+	case property != nil && envVarKey != nil:
+		v.errorf(ref, p, "fromService can't set both property and envVarKey")
+	case property != nil && !slices.Contains(FromServiceProperties, property.Value):
+		v.errorf(property, p+".property", "invalid property %q, expected one of %s", property.Value, strings.Join(FromServiceProperties, ", "))
+	}
+
+	if name != nil {
+		r := envVarRef{node: name, path: p + ".name", kind: "service", name: name.Value}
+		if typ != nil {
+			r.refType = typ.Value
+		}
+		v.refs = append(v.refs, r)
+	}
+}
+
+func (v *validator) validateFromDatabase(ref *yaml.Node, p string) {
+	if ref.Kind != yaml.MappingNode {
+		v.errorf(ref, p, "expected a mapping with name and property")
+		return
+	}
+
+	name := v.requireString(ref, p, "name")
+	if property := v.requireString(ref, p, "property"); property != nil && !slices.Contains(FromDatabaseProperties, property.Value) {
+		v.errorf(property, p+".property", "invalid property %q, expected one of %s", property.Value, strings.Join(FromDatabaseProperties, ", "))
+	}
+
+	if name != nil {
+		v.refs = append(v.refs, envVarRef{node: name, path: p + ".name", kind: "database", name: name.Value})
+	}
+}
+
+func (v *validator) validateRefs() {
+	for _, ref := range v.refs {
+		switch ref.kind {
+		case "service":
+			typ, ok := v.serviceTypes[ref.name]
+			if !ok {
+				// services created outside the Blueprint can also be referenced, so this is only a warning
+				v.warnf(ref.node, ref.path, "service %q is not defined in this file", ref.name)
+			} else if ref.refType != "" && typ != ref.refType {
+				v.errorf(ref.node, ref.path, "service %q has type %s, not %s", ref.name, typ, ref.refType)
+			}
+		case "database":
+			if _, ok := v.databases[ref.name]; !ok {
+				v.warnf(ref.node, ref.path, "database %q is not defined in this file", ref.name)
+			}
+		case "envVarGroup":
+			if _, ok := v.envVarGroups[ref.name]; !ok {
+				v.warnf(ref.node, ref.path, "envVarGroup %q is not defined in this file", ref.name)
+			}
+		}
+	}
+}
+
+func (v *validator) checkRegion(n *yaml.Node, p string) {
+	if region := scalar(n, "region"); region != nil && !slices.Contains(Regions, region.Value) {
+		v.errorf(region, p+".region", "invalid region %q, expected one of %s", region.Value, strings.Join(Regions, ", "))
+	}
+}
+
+func (v *validator) checkKeys(n *yaml.Node, p string, known []string) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key := n.Content[i]
+		if !slices.Contains(known, key.Value) {
+			v.warnf(key, joinPath(p, key.Value), "unknown key %q", key.Value)
+		}
+	}
+}
+
+// requireString returns the scalar value of key, reporting an error when it is missing or empty
+func (v *validator) requireString(n *yaml.Node, p, key string) *yaml.Node {
+	val := value(n, key)
+	if val == nil {
+		v.errorf(n, p, "missing required key %q", key)
+		return nil
+	}
+	if val.Kind != yaml.ScalarNode || val.Value == "" {
+		v.errorf(val, joinPath(p, key), "%s must be a non-empty string", key)
+		return nil
+	}
+	return val
+}
+
+// sequence returns the items of a list under key, reporting an error when the key is not a list
+func (v *validator) sequence(n *yaml.Node, p, key string) []*yaml.Node {
+	val := value(n, key)
+	if val == nil {
+		return nil
+	}
+	if val.Kind != yaml.SequenceNode {
+		v.errorf(val, joinPath(p, key), "%s must be a list", key)
+		return nil
+	}
+	return val.Content
+}
+
+func value(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalar(n *yaml.Node, key string) *yaml.Node {
+	val := value(n, key)
+	if val == nil || val.Kind != yaml.ScalarNode {
+		return nil
+	}
+	return val
+}
+
+func indexPath(p string, i int) string {
+	return fmt.Sprintf("%s[%d]", p, i)
+}
+
+func joinPath(p, key string) string {
+	if p == "" {
+		return key
+	}
+	return p + "." + key
+}
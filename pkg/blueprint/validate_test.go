@@ -0,0 +1,187 @@
+package blueprint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+)
+
+func TestValidateCronSchedule(t *testing.T) {
+	for _, schedule := range []string{"*/15 * * * *", "0 3 * * MON-FRI", "0 0 1,15 JAN-JUN 7", "5-10/2 0 * * *"} {
+		assert.NoError(t, blueprint.ValidateCronSchedule(schedule), schedule)
+	}
+
+	for _, schedule := range []string{"* * * *", "60 * * * *", "* 24 * * *", "0 0 0 * *", "*/0 * * * *", "10-5 * * * *", "0 0 * FOO *"} {
+		assert.Error(t, blueprint.ValidateCronSchedule(schedule), schedule)
+	}
+}
+
+func TestValidateValid(t *testing.T) {
+	data := []byte(`
+services:
+  - type: web
+    name: api
+    runtime: node
+    plan: starter
+    region: oregon
+    envVars:
+      - key: DATABASE_URL
+        fromDatabase:
+          name: db
+          property: connectionString
+      - key: REDIS_URL
+        fromService:
+          name: cache
+          type: keyvalue
+          property: connectionString
+      - key: SECRET
+        sync: false
+      - fromGroup: shared
+  - type: keyvalue
+    name: cache
+    ipAllowList: []
+  - type: cron
+    name: nightly
+    runtime: python
+    schedule: "0 3 * * *"
+    disk:
+      name: data
+      mountPath: /data
+      sizeGB: 10
+databases:
+  - name: db
+    plan: basic-256mb
+envVarGroups:
+  - name: shared
+    envVars:
+      - key: LOG_LEVEL
+        value: info
+`)
+
+	res := blueprint.Validate("render.yaml", data)
+	assert.True(t, res.Valid)
+	assert.Empty(t, res.Issues)
+}
+
+func TestValidateErrors(t *testing.T) {
+	data := []byte(`services:
+  - type: static
+    name: site
+    runtime: static
+  - type: worker
+    name: site
+    runtime: go
+    plan: free
+  - type: cron
+    name: job
+    runtime: ruby
+    schedule: "61 * * * *"
+    envVars:
+      - key: A
+        value: x
+      - key: A
+        generateValue: true
+      - key: B
+        fromService:
+          name: job
+          type: web
+          property: host
+      - key: C
+        fromDatabase:
+          name: missing
+          property: host
+databases:
+  - name: db
+    region: mars
+`)
+
+	res := blueprint.Validate("render.yaml", data)
+	assert.False(t, res.Valid)
+
+	type located struct {
+		line     int
+		severity string
+	}
+	var got []located
+	for _, issue := range res.Issues {
+		got = append(got, located{issue.Line, issue.Severity})
+	}
+
+	assert.Equal(t, []located{
+		{2, blueprint.SeverityError},    // type: static
+		{6, blueprint.SeverityError},    // duplicate name
+		{8, blueprint.SeverityError},    // free worker
+		{12, blueprint.SeverityError},   // cron schedule
+		{16, blueprint.SeverityError},   // duplicate env var
+		{20, blueprint.SeverityError},   // fromService type mismatch
+		{25, blueprint.SeverityWarning}, // undefined database
+		{29, blueprint.SeverityError},   // region
+	}, got)
+	assert.Equal(t, 7, res.Errors)
+	assert.Equal(t, 1, res.Warnings)
+	assert.Contains(t, res.Issues[1].Message, "first defined on line 3")
+	assert.Equal(t, "services[2].envVars[2].fromService.name", res.Issues[5].Path)
+}
+
+func TestValidateProjects(t *testing.T) {
+	res := blueprint.Validate("render.yaml", []byte(`
+projects:
+  - name: shop
+    environments:
+      - name: production
+        protectionEnabled: true
+        services:
+          - type: web
+            name: api
+            runtime: node
+            envVars:
+              - key: DATABASE_URL
+                fromDatabase:
+                  name: db
+                  property: connectionString
+        databases:
+          - name: db
+      - name: staging
+        services:
+          - type: worker
+            name: jobs
+            runtime: go
+            plan: free
+`))
+	assert.False(t, res.Valid)
+	require.Len(t, res.Issues, 1)
+	assert.Equal(t, "projects[0].environments[1].services[0].plan", res.Issues[0].Path)
+	assert.Contains(t, res.Issues[0].Message, "free plan")
+
+	res = blueprint.Validate("render.yaml", []byte(`
+projects:
+  - name: shop
+`))
+	assert.False(t, res.Valid)
+	require.Len(t, res.Issues, 2)
+	assert.Contains(t, res.Issues[1].Message, "at least one environment")
+}
+
+func TestValidateSyntaxError(t *testing.T) {
+	res := blueprint.Validate("render.yaml", []byte("services:\n  - type: web\n    name: a: b\n"))
+	require.Len(t, res.Issues, 1)
+	assert.Equal(t, 3, res.Issues[0].Line)
+	assert.False(t, res.Valid)
+}
+
+func TestValidateUnknownKeys(t *testing.T) {
+	res := blueprint.Validate("render.yaml", []byte(`
+services:
+  - type: web
+    name: api
+    runtime: node
+    startComand: npm start
+`))
+	assert.True(t, res.Valid)
+	require.Len(t, res.Issues, 1)
+	assert.Equal(t, blueprint.SeverityWarning, res.Issues[0].Severity)
+	assert.Equal(t, "services[0].startComand", res.Issues[0].Path)
+}
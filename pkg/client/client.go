@@ -157,9 +157,22 @@ func ListAll[T any, P paginationParams](ctx context.Context, params P, listPage
 
 		res = append(res, page...)
 
-		if len(page) < limit {
+		// without a cursor the next request would return the first page again
+		if len(page) < limit || cursor == nil {
 			return res, nil
 		}
 		params.SetCursor(cursor)
 	}
 }
+
+// LastCursor returns the cursor of the last item in a list response body. Some generated list types leave out the
+// cursor of each item, so it is read from the body instead.
+func LastCursor(body []byte) *Cursor {
+	var items []struct {
+		Cursor *Cursor `json:"cursor"`
+	}
+	if err := json.Unmarshal(body, &items); err != nil || len(items) == 0 {
+		return nil
+	}
+	return items[len(items)-1].Cursor
+}
@@ -1,12 +1,14 @@
 package client_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/pointers"
 )
 
 func TestErrorFromResponse(t *testing.T) {
@@ -55,3 +57,40 @@ func TestErrorFromResponse(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestListAll(t *testing.T) {
+	page := func(start, n int) []int {
+		res := make([]int, n)
+		for i := range res {
+			res[i] = start + i
+		}
+		return res
+	}
+
+	t.Run("follows cursors until a page is not full", func(t *testing.T) {
+		var cursors []string
+		res, err := client.ListAll(context.Background(), &client.ListEnvGroupsParams{}, func(_ context.Context, params *client.ListEnvGroupsParams) ([]int, *client.Cursor, error) {
+			if params.Cursor == nil {
+				return page(0, 100), pointers.From("c1"), nil
+			}
+			cursors = append(cursors, *params.Cursor)
+			return page(100, 20), pointers.From("c2"), nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res, 120)
+		require.Equal(t, []string{"c1"}, cursors)
+	})
+
+	t.Run("stops without a cursor", func(t *testing.T) {
+		calls := 0
+		res, err := client.ListAll(context.Background(), &client.ListEnvGroupsParams{}, func(_ context.Context, _ *client.ListEnvGroupsParams) ([]int, *client.Cursor, error) {
+			calls++
+			return page(0, 100), nil, nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res, 100)
+		require.Equal(t, 1, calls)
+	})
+}
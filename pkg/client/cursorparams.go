@@ -83,3 +83,45 @@ func (p *ListSecretFilesForServiceParams) SetCursor(c *Cursor) {
 func (p *ListSecretFilesForServiceParams) SetLimit(l int) {
 	p.Limit = &l
 }
+
+func (p *ListDisksParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListDisksParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListBlueprintsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListBlueprintsParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListBlueprintSyncsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListBlueprintSyncsParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListNotificationOverridesParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListNotificationOverridesParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListEnvGroupsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListEnvGroupsParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListRegistryCredentialsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListRegistryCredentialsParams) SetLimit(l int) {
+	p.Limit = &l
+}
@@ -40,6 +40,20 @@ func (s *stringSliceFormValue) String() string {
 	return str
 }
 
+// StringArray is the field type for StringArray flags. Its values may contain commas, so they are never joined or
+// split on commas. Forms show one value per line instead.
+type StringArray []string
+
+func stringArrayFromString(str string) StringArray {
+	values := StringArray{}
+	for _, line := range strings.Split(str, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			values = append(values, line)
+		}
+	}
+	return values
+}
+
 type FormValues map[string]FormValue
 
 func FormValuesFromStruct(v any) FormValues {
@@ -88,6 +102,12 @@ func FormValuesFromStruct(v any) FormValues {
 				formValues[cliTag] = NewStringFormValue(fmt.Sprintf("%t", *val))
 			}
 		case reflect.Slice:
+			if field.Type == reflect.TypeOf(StringArray{}) {
+				val := elemField.Interface().(StringArray)
+				formValues[cliTag] = NewStringFormValue(strings.Join(val, "\n"))
+				continue
+			}
+
 			switch field.Type.Elem().Kind() {
 			case reflect.String:
 				val := elemField.Interface().([]string)
@@ -222,6 +242,15 @@ func StructFromFormValues(formValues FormValues, v any) error {
 				return fmt.Errorf("unsupported pointer type: %s", field.Type.Elem().Kind())
 			}
 		case reflect.Slice:
+			if field.Type == reflect.TypeOf(StringArray{}) {
+				val, ok := formValues[cliTag]
+				if !ok {
+					continue
+				}
+				elemField.Set(reflect.ValueOf(stringArrayFromString(val.String())))
+				continue
+			}
+
 			switch field.Type.Elem().Kind() {
 			case reflect.String:
 				val, ok := formValues[cliTag]
@@ -370,6 +399,17 @@ func HuhFormFields(cmd *cobra.Command, v any) ([]huh.Field, FormValues) {
 				Value((*string)(timeValue)).
 				Placeholder(fmt.Sprintf("Relative time or %s", time.RFC3339)).
 				SuggestionsFunc(func() []string { return TimeSuggestion(timeValue.String()) }, timeValue)
+		} else if flag.Value.Type() == "stringArray" {
+			arrayValue := NewStringFormValue(value.String())
+			formValues[flag.Name] = arrayValue
+
+			huhFieldMap[flag.Name] = huh.NewText().
+				Key(flag.Name).
+				Title(flag.Name).
+				Description(wrappedDescription).
+				Placeholder("One per line, alt+enter for a new line").
+				Lines(3).
+				Value((*string)(arrayValue))
 		} else {
 			strValue := NewStringFormValue(value.String())
 			formValues[flag.Name] = strValue
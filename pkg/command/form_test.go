@@ -38,6 +38,15 @@ func TestFormValuesFromStruct(t *testing.T) {
 		formValues := command.FormValuesFromStruct(&v)
 		require.Equal(t, "owner-id-1,owner-id-2", formValues["owners"].String())
 	})
+
+	t.Run("converts string array type one value per line", func(t *testing.T) {
+		type testStruct struct {
+			Where command.StringArray `cli:"where"`
+		}
+		v := testStruct{Where: command.StringArray{"path=~^/a{1,3}", "msg==a,b"}}
+		formValues := command.FormValuesFromStruct(&v)
+		require.Equal(t, "path=~^/a{1,3}\nmsg==a,b", formValues["where"].String())
+	})
 }
 
 func TestStructFromFormValues(t *testing.T) {
@@ -74,6 +83,16 @@ func TestStructFromFormValues(t *testing.T) {
 		require.Equal(t, []string{"owner-id-1", "owner-id-2"}, v.OwnerIDs)
 	})
 
+	t.Run("converts string array type without splitting on commas", func(t *testing.T) {
+		type testStruct struct {
+			Where command.StringArray `cli:"where"`
+		}
+		formValues := command.FormValues{"where": command.NewStringFormValue("path=~^/a{1,3}\n\nmsg==a,b\n")}
+		v := testStruct{}
+		require.NoError(t, command.StructFromFormValues(formValues, &v))
+		require.Equal(t, command.StringArray{"path=~^/a{1,3}", "msg==a,b"}, v.Where)
+	})
+
 	t.Run("converts time type", func(t *testing.T) {
 		type testStruct struct {
 			Time *command.TimeOrRelative `cli:"time"`
@@ -169,4 +188,22 @@ func TestHuhForm(t *testing.T) {
 		// Find placeholder text
 		require.Contains(t, form.View(), "Relative time or")
 	})
+	t.Run("creates form with string array", func(t *testing.T) {
+		type testStruct struct {
+			Where command.StringArray `cli:"where"`
+		}
+		v := testStruct{Where: command.StringArray{"msg==a,b", "status>=500"}}
+		cmd := cobra.Command{}
+		cmd.Flags().StringArray("where", []string{}, "")
+
+		fields, values := command.HuhFormFields(&cmd, &v)
+		form := huh.NewForm(huh.NewGroup(fields...))
+		form.Init()
+
+		require.Contains(t, form.View(), "msg==a,b")
+
+		var parsed testStruct
+		require.NoError(t, command.StructFromFormValues(values, &parsed))
+		require.Equal(t, v.Where, parsed.Where)
+	})
 }
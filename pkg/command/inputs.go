@@ -83,6 +83,11 @@ func getStringSliceValue(flags *pflag.FlagSet, tag string) ([]string, error) {
 			val := cobraEnum.SelectedValues()
 			return val, nil
 		}
+
+		// String arrays keep commas inside each value instead of splitting on them
+		if flag.Value.Type() == "stringArray" {
+			return flags.GetStringArray(tag)
+		}
 	}
 
 	val, err := flags.GetStringSlice(tag)
@@ -297,12 +302,105 @@ func ParseCommand(cmd *cobra.Command, args []string, v any) error {
 }
 
 func InputToString(v any) (string, error) {
+	args, flags, err := inputArgs(v)
+	if err != nil {
+		return "", err
+	}
+
+	argsString := strings.Trim(strings.Join(args, " "), " ")
+	flagsString := strings.Join(flags, " ")
+
+	return strings.Trim(fmt.Sprintf("%s %s", argsString, flagsString), " "), nil
+}
+
+// InputToArgs returns the command line for v as separate arguments, so values with spaces survive being stored and
+// read back with InputFromArgs
+func InputToArgs(v any) ([]string, error) {
+	args, flags, err := inputArgs(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, arg := range args {
+		if arg != "" {
+			result = append(result, arg)
+		}
+	}
+	return append(result, flags...), nil
+}
+
+// InputFromArgs sets the fields of v from arguments returned by InputToArgs. Unlike ParseCommand, it doesn't need
+// the command's flags to be registered.
+func InputFromArgs(args []string, v any) error {
+	arrays := stringArrayTags(v)
+	values := make(FormValues)
+	position := 0
+	for _, arg := range args {
+		name, ok := strings.CutPrefix(arg, "--")
+		if !ok {
+			values[fmt.Sprintf("arg:%d", position)] = NewStringFormValue(arg)
+			position++
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(name, "=")
+		if !hasValue {
+			value = "true"
+		}
+		// String arrays repeat their flag for each value, the form value holds one per line
+		if prev, ok := values[name]; ok && arrays[name] {
+			value = prev.String() + "\n" + value
+		}
+		values[name] = NewStringFormValue(value)
+	}
+
+	return StructFromFormValues(values, v)
+}
+
+// SetFlagsFromArgs sets flags from arguments returned by InputToArgs, except flags that were passed on the command
+// line. A flag that repeats is set once per value, so string arrays get all of their values.
+func SetFlagsFromArgs(flags *pflag.FlagSet, args []string) error {
+	passed := make(map[string]bool)
+	flags.Visit(func(flag *pflag.Flag) {
+		passed[flag.Name] = true
+	})
+
+	for _, arg := range args {
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !hasValue {
+			value = "true"
+		}
+		if passed[name] {
+			continue
+		}
+		if err := flags.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func stringArrayTags(v any) map[string]bool {
+	tags := make(map[string]bool)
+	vtype := reflect.TypeOf(v).Elem()
+	for i := 0; i < vtype.NumField(); i++ {
+		field := vtype.Field(i)
+		if field.Type == reflect.TypeOf(StringArray{}) {
+			tags[field.Tag.Get("cli")] = true
+		}
+	}
+	return tags
+}
+
+// inputArgs returns the positional arguments of v by index, with empty strings for missing ones, and its flags
+func inputArgs(v any) ([]string, []string, error) {
 	vtype := reflect.TypeOf(v).Elem()
 	elem := reflect.ValueOf(v).Elem()
 
 	// Create a slice to store the arguments. The size is the maximum number of fields.
 	args := make([]string, vtype.NumField())
-	var flagsStr []string
+	var flags []string
 
 	// Loop through the struct fields
 	for i := 0; i < vtype.NumField(); i++ {
@@ -317,19 +415,31 @@ func InputToString(v any) (string, error) {
 
 		elemField := elem.FieldByName(field.Name)
 
+		var strVal string
+
 		// If the field is a pointer, get the value
 		if field.Type.Kind() == reflect.Ptr {
 			if elemField.IsNil() {
 				continue
 			}
 
+			// Types like TimeOrRelative format themselves the way they're parsed
+			if stringer, ok := elemField.Interface().(fmt.Stringer); ok {
+				strVal = stringer.String()
+			}
 			elemField = elemField.Elem()
 		}
 
-		var strVal string
-
-		// If the field is a slice, join the values
-		if field.Type.Kind() == reflect.Slice {
+		switch {
+		case strVal != "":
+		case field.Type == reflect.TypeOf(StringArray{}):
+			// String array values may contain commas, so the flag is repeated for each value instead of joining them
+			for i := 0; i < elemField.Len(); i++ {
+				flags = append(flags, fmt.Sprintf("--%s=%s", cliTag, elemField.Index(i)))
+			}
+			continue
+		case field.Type.Kind() == reflect.Slice:
+			// If the field is a slice, join the values
 			if elemField.Len() == 0 {
 				continue
 			}
@@ -339,7 +449,7 @@ func InputToString(v any) (string, error) {
 				slice = append(slice, fmt.Sprintf("%v", elemField.Index(i)))
 			}
 			strVal = strings.Join(slice, ",")
-		} else {
+		default:
 			if elemField.IsZero() {
 				continue
 			}
@@ -352,17 +462,14 @@ func InputToString(v any) (string, error) {
 			// This should never error. It means the tag is not formatted correctly.
 			index, err := strconv.Atoi(indexStr)
 			if err != nil {
-				return "", fmt.Errorf("internal failure parsing arguments")
+				return nil, nil, fmt.Errorf("internal failure parsing arguments")
 			}
 
 			args[index] = strVal
 		} else {
-			flagsStr = append(flagsStr, fmt.Sprintf("--%s=%s", cliTag, strVal))
+			flags = append(flags, fmt.Sprintf("--%s=%s", cliTag, strVal))
 		}
 	}
 
-	argsString := strings.Trim(strings.Join(args, " "), " ")
-	flagsString := strings.Join(flagsStr, " ")
-
-	return strings.Trim(fmt.Sprintf("%s %s", argsString, flagsString), " "), nil
+	return args, flags, nil
 }
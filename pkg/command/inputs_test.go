@@ -108,6 +108,36 @@ func TestParseCommand(t *testing.T) {
 		require.Equal(t, []string{"bar", "baz"}, v.Foo)
 	})
 
+	t.Run("parse string array", func(t *testing.T) {
+		type testStruct struct {
+			Foo []string `cli:"foo"`
+		}
+		var v testStruct
+		cmd := &cobra.Command{}
+		cmd.Flags().StringArray("foo", []string{}, "")
+		require.NoError(t, cmd.ParseFlags([]string{"--foo", "msg=~a{1,3}", "--foo", "status>=500"}))
+
+		err := command.ParseCommand(cmd, []string{}, &v)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"msg=~a{1,3}", "status>=500"}, v.Foo)
+	})
+
+	t.Run("parse string array into a string array field", func(t *testing.T) {
+		type testStruct struct {
+			Foo command.StringArray `cli:"foo"`
+		}
+		var v testStruct
+		cmd := &cobra.Command{}
+		cmd.Flags().StringArray("foo", []string{}, "")
+		require.NoError(t, cmd.ParseFlags([]string{"--foo", "msg==a,b"}))
+
+		err := command.ParseCommand(cmd, []string{}, &v)
+		require.NoError(t, err)
+
+		require.Equal(t, command.StringArray{"msg==a,b"}, v.Foo)
+	})
+
 	t.Run("arg parsing", func(t *testing.T) {
 		t.Run("simple arg", func(t *testing.T) {
 			type testStruct struct {
@@ -214,3 +244,62 @@ func TestInputToString(t *testing.T) {
 		require.Equal(t, "", str)
 	})
 }
+
+func TestInputToArgs(t *testing.T) {
+	type testStruct struct {
+		Arg0  string                  `cli:"arg:0"`
+		Text  []string                `cli:"text"`
+		Start *command.TimeOrRelative `cli:"start"`
+		Limit int                     `cli:"limit"`
+		Tail  bool                    `cli:"tail"`
+	}
+
+	start, err := command.ParseTime(time.Now(), pointers.From("1h"))
+	require.NoError(t, err)
+
+	v := testStruct{Arg0: "abc", Text: []string{"connection reset", "timeout"}, Start: start, Limit: 50, Tail: true}
+	args, err := command.InputToArgs(&v)
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc", "--text=connection reset,timeout", "--start=1h", "--limit=50", "--tail=true"}, args)
+
+	var parsed testStruct
+	require.NoError(t, command.InputFromArgs(args, &parsed))
+	require.Equal(t, v.Arg0, parsed.Arg0)
+	require.Equal(t, v.Text, parsed.Text)
+	require.Equal(t, "1h", parsed.Start.String())
+	require.Equal(t, v.Limit, parsed.Limit)
+	require.True(t, parsed.Tail)
+}
+
+func TestStringArrayPresetRoundTrip(t *testing.T) {
+	type testStruct struct {
+		Level string              `cli:"level"`
+		Where command.StringArray `cli:"where"`
+	}
+
+	v := testStruct{Level: "error", Where: command.StringArray{"status>=500", "msg=~a{1,3}"}}
+	args, err := command.InputToArgs(&v)
+	require.NoError(t, err)
+	require.Equal(t, []string{"--level=error", "--where=status>=500", "--where=msg=~a{1,3}"}, args)
+
+	t.Run("input from args", func(t *testing.T) {
+		var parsed testStruct
+		require.NoError(t, command.InputFromArgs(args, &parsed))
+		require.Equal(t, v, parsed)
+	})
+
+	t.Run("set flags from args", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("level", "", "")
+		cmd.Flags().StringArray("where", []string{}, "")
+		require.NoError(t, cmd.ParseFlags([]string{"--level", "warning"}))
+
+		require.NoError(t, command.SetFlagsFromArgs(cmd.Flags(), args))
+
+		var parsed testStruct
+		require.NoError(t, command.ParseCommand(cmd, []string{}, &parsed))
+		// flags that were passed win over the preset
+		require.Equal(t, "warning", parsed.Level)
+		require.Equal(t, v.Where, parsed.Where)
+	})
+}
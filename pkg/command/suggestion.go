@@ -0,0 +1,30 @@
+package command
+
+import (
+	"slices"
+	"strings"
+)
+
+// ListSuggestion suggests values to complete the last item of a comma separated list, keeping the items before it.
+// Values already in the list aren't suggested again.
+func ListSuggestion(str string, values []string) []string {
+	prefix, last := "", str
+	if i := strings.LastIndex(str, ","); i >= 0 {
+		prefix, last = str[:i+1], str[i+1:]
+	}
+
+	var existing []string
+	for _, item := range strings.Split(prefix, ",") {
+		existing = append(existing, strings.TrimSpace(item))
+	}
+	last = strings.ToLower(strings.TrimSpace(last))
+
+	var suggestions []string
+	for _, value := range values {
+		if !strings.HasPrefix(strings.ToLower(value), last) || slices.Contains(existing, value) {
+			continue
+		}
+		suggestions = append(suggestions, prefix+value)
+	}
+	return suggestions
+}
@@ -0,0 +1,46 @@
+package command_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/command"
+)
+
+func TestListSuggestion(t *testing.T) {
+	values := []string{"srv-123-abcde", "srv-123-fghij", "srv-456-klmno"}
+
+	tcs := []struct {
+		name     string
+		str      string
+		expected []string
+	}{
+		{
+			name:     "suggests every value when empty",
+			str:      "",
+			expected: values,
+		},
+		{
+			name:     "matches the prefix",
+			str:      "SRV-123",
+			expected: []string{"srv-123-abcde", "srv-123-fghij"},
+		},
+		{
+			name:     "completes the last item of a list",
+			str:      "srv-123-abcde,srv-",
+			expected: []string{"srv-123-abcde,srv-123-fghij", "srv-123-abcde,srv-456-klmno"},
+		},
+		{
+			name:     "no match",
+			str:      "web",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, command.ListSuggestion(tc.str, values))
+		})
+	}
+}
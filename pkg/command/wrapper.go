@@ -168,6 +168,15 @@ func AddToStack[T any](stack *tui.StackModel, cmd *cobra.Command, breadcrumb str
 	return stack.Push(*modelWithCmd)
 }
 
+// ShowResultFunc returns a form action that shows the message returned when the form is submitted in a new view
+func ShowResultFunc[T any](ctx context.Context, cmd *cobra.Command, breadcrumb string, in T) func(string) tea.Cmd {
+	return func(result string) tea.Cmd {
+		return AddToStackFunc(ctx, cmd, breadcrumb, in, tui.NewSimpleModel(func() tea.Msg {
+			return tui.LoadDataMsg[string]{Data: result}
+		}))
+	}
+}
+
 func LoadCmd[T any, D any](ctx context.Context, loadData func(context.Context, T) (D, error), in T) tui.TypedCmd[D] {
 	loadDataCmd := func() tea.Msg {
 		return tui.LoadingDataMsg{
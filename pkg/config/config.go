@@ -31,6 +31,9 @@ type Config struct {
 
 	APIConfig    `yaml:"api"`
 	DashboardURL string `yaml:"dashboard_url,omitempty"`
+
+	// LogPresets are saved logs queries by name, stored as the flags of the logs command
+	LogPresets map[string][]string `yaml:"log_presets,omitempty"`
 }
 
 type APIConfig struct {
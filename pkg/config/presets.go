@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var presetNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+func LogPresets() (map[string][]string, error) {
+	cfg, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.LogPresets, nil
+}
+
+// SaveLogPreset saves the logs flags under name, replacing any preset with the same name
+func SaveLogPreset(name string, args []string) error {
+	if !presetNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid preset name %q: use letters, numbers, dashes, dots and underscores", name)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+
+	if cfg.LogPresets == nil {
+		cfg.LogPresets = map[string][]string{}
+	}
+	cfg.LogPresets[name] = args
+	return cfg.Persist()
+}
+
+func DeleteLogPreset(name string) error {
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := cfg.LogPresets[name]; !ok {
+		return fmt.Errorf("no log preset named %q", name)
+	}
+	delete(cfg.LogPresets, name)
+	return cfg.Persist()
+}
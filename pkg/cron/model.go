@@ -0,0 +1,35 @@
+package cron
+
+import (
+	"github.com/renderinc/cli/pkg/client"
+	clientevents "github.com/renderinc/cli/pkg/client/events"
+)
+
+// IsSuccessful returns true if the run finished without error
+func IsSuccessful(status client.CronJobRunStatus) bool {
+	return status == client.CronJobRunStatusSuccessful
+}
+
+// EndedRun returns the run updated with the status of its cron_job_run_ended event, or nil if the run hasn't ended.
+// There is no endpoint to retrieve a single run, so events are the only way to learn how it finished.
+func EndedRun(run *client.CronJobRun, evts []clientevents.ServiceEvent) (*client.CronJobRun, error) {
+	for _, e := range evts {
+		if e.Type != clientevents.CronJobRunEnded {
+			continue
+		}
+
+		details, err := e.Details.AsCronJobRunEndedEvent()
+		if err != nil {
+			return nil, err
+		}
+		if details.CronJobRunId != run.Id {
+			continue
+		}
+
+		ended := *run
+		ended.Status = client.CronJobRunStatus(details.Status)
+		ended.FinishedAt = &e.Timestamp
+		return &ended, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,49 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientevents "github.com/renderinc/cli/pkg/client/events"
+	"github.com/renderinc/cli/pkg/cron"
+)
+
+func endedEvent(t *testing.T, runID string, status clientevents.CronJobRunStatus, ts time.Time) clientevents.ServiceEvent {
+	var details clientevents.EventDetails
+	require.NoError(t, details.FromCronJobRunEndedEvent(clientevents.CronJobRunEndedEvent{
+		CronJobRunId: runID,
+		Status:       status,
+	}))
+	return clientevents.ServiceEvent{Type: clientevents.CronJobRunEnded, Details: details, Timestamp: ts}
+}
+
+func TestEndedRun(t *testing.T) {
+	run := &client.CronJobRun{Id: "crr-1", Status: client.CronJobRunStatusPending}
+	finishedAt := time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)
+
+	t.Run("not ended", func(t *testing.T) {
+		var started clientevents.EventDetails
+		require.NoError(t, started.FromCronJobRunStartedEvent(clientevents.CronJobRunStartedEvent{CronJobRunId: "crr-1"}))
+
+		ended, err := cron.EndedRun(run, []clientevents.ServiceEvent{
+			{Type: clientevents.CronJobRunStarted, Details: started},
+			endedEvent(t, "crr-0", clientevents.Successful, finishedAt),
+		})
+		require.NoError(t, err)
+		require.Nil(t, ended)
+	})
+
+	t.Run("ended", func(t *testing.T) {
+		ended, err := cron.EndedRun(run, []clientevents.ServiceEvent{
+			endedEvent(t, "crr-1", clientevents.Unsuccessful, finishedAt),
+		})
+		require.NoError(t, err)
+		require.Equal(t, client.CronJobRunStatusUnsuccessful, ended.Status)
+		require.Equal(t, finishedAt, *ended.FinishedAt)
+		require.False(t, cron.IsSuccessful(ended.Status))
+		require.Equal(t, client.CronJobRunStatusPending, run.Status)
+	})
+}
@@ -0,0 +1,67 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientevents "github.com/renderinc/cli/pkg/client/events"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+func (r *Repo) RunCronJob(ctx context.Context, cronJobID string) (*client.CronJobRun, error) {
+	resp, err := r.client.RunCronJobWithResponse(ctx, cronJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// CancelCronJobRun cancels the active run of the cron job, if any
+func (r *Repo) CancelCronJobRun(ctx context.Context, cronJobID string) error {
+	resp, err := r.client.CancelCronJobRunWithResponse(ctx, cronJobID)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// ListEvents returns up to 100 events for the cron job since the given time
+func (r *Repo) ListEvents(ctx context.Context, cronJobID string, since time.Time) ([]clientevents.ServiceEvent, error) {
+	resp, err := r.client.ListEventsWithResponse(ctx, cronJobID, &client.ListEventsParams{
+		StartTime: &since,
+		Limit:     pointers.From(100),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response: %v", resp.Status())
+	}
+
+	res := make([]clientevents.ServiceEvent, 0, len(*resp.JSON200))
+	for _, e := range *resp.JSON200 {
+		res = append(res, e.Event)
+	}
+	return res, nil
+}
@@ -0,0 +1,131 @@
+package disk
+
+import (
+	"context"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+func (r *Repo) ListDisks(ctx context.Context, params *client.ListDisksParams) ([]*clientdisks.DiskDetails, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+func (r *Repo) listPage(ctx context.Context, params *client.ListDisksParams) ([]*clientdisks.DiskDetails, *client.Cursor, error) {
+	resp, err := r.client.ListDisksWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	disks := make([]*clientdisks.DiskDetails, 0, len(res))
+	for _, diskWithCursor := range res {
+		disks = append(disks, &diskWithCursor.Disk)
+	}
+
+	return disks, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) GetDisk(ctx context.Context, id string) (*clientdisks.DiskDetails, error) {
+	resp, err := r.client.RetrieveDiskWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) CreateDisk(ctx context.Context, data clientdisks.DiskPOST) (*clientdisks.DiskDetails, error) {
+	resp, err := r.client.AddDiskWithResponse(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (r *Repo) UpdateDisk(ctx context.Context, id string, data clientdisks.DiskPATCH) (*clientdisks.DiskDetails, error) {
+	resp, err := r.client.UpdateDiskWithResponse(ctx, id, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteDisk(ctx context.Context, id string) error {
+	resp, err := r.client.DeleteDiskWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) ListSnapshots(ctx context.Context, id string) ([]client.DiskSnapshot, error) {
+	resp, err := r.client.ListSnapshotsWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// The API schema documents the snapshot list as a 201 response
+	if resp.JSON201 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON201, nil
+}
+
+func (r *Repo) RestoreSnapshot(ctx context.Context, id string, data client.SnapshotRestorePOST) (*clientdisks.DiskDetails, error) {
+	resp, err := r.client.RestoreSnapshotWithResponse(ctx, id, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
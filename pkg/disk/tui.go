@@ -0,0 +1,55 @@
+package disk
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func Header() []string {
+	return []string{"Name", "Mount Path", "Size", "Service", "ID"}
+}
+
+func Row(d *clientdisks.DiskDetails) []string {
+	return []string{
+		d.Name,
+		d.MountPath,
+		SizeValue(d.SizeGB),
+		pointers.StringValue(d.ServiceId),
+		d.Id,
+	}
+}
+
+func SnapshotHeader() []string {
+	return []string{"Created", "Instance", "Snapshot Key"}
+}
+
+func SnapshotRow(s client.DiskSnapshot) []string {
+	return []string{
+		pointers.TimeValue(s.CreatedAt),
+		pointers.StringValue(s.InstanceId),
+		pointers.StringValue(s.SnapshotKey),
+	}
+}
+
+// Details formats all the fields of a disk as aligned key value lines
+func Details(d *clientdisks.DiskDetails) string {
+	lines := []string{
+		fmt.Sprintf("%-12s %s", "ID:", d.Id),
+		fmt.Sprintf("%-12s %s", "Name:", d.Name),
+		fmt.Sprintf("%-12s %s", "Mount Path:", d.MountPath),
+		fmt.Sprintf("%-12s %s", "Size:", SizeValue(d.SizeGB)),
+		fmt.Sprintf("%-12s %s", "Service:", pointers.StringValue(d.ServiceId)),
+		fmt.Sprintf("%-12s %s", "Created:", d.CreatedAt.Format(time.RFC3339)),
+		fmt.Sprintf("%-12s %s", "Updated:", d.UpdatedAt.Format(time.RFC3339)),
+	}
+	return strings.Join(lines, "\n")
+}
+
+func SizeValue(sizeGB int) string {
+	return fmt.Sprintf("%d GB", sizeGB)
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+// RenderApexIP is the load balancer address apex domains should point their A record at
+const RenderApexIP = "216.24.57.1"
+
+const (
+	RecordTypeA     = "A"
+	RecordTypeCNAME = "CNAME"
+)
+
+// Record is a DNS record the domain owner needs to create at their DNS provider
+type Record struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// RecordCheck is the result of comparing an expected record against what public DNS currently returns
+type RecordCheck struct {
+	Record Record   `json:"record"`
+	Actual []string `json:"actual"`
+	OK     bool     `json:"ok"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// Resolver is the subset of net.Resolver used to check records
+type Resolver interface {
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DefaultResolver is the resolver used for local DNS checks
+var DefaultResolver Resolver = net.DefaultResolver
+
+// TargetHost returns the onrender.com hostname custom domains of the service should point to
+func TargetHost(svc *client.Service) (string, error) {
+	var serviceURL string
+	switch svc.Type {
+	case client.WebService:
+		details, err := svc.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return "", err
+		}
+		serviceURL = details.Url
+	case client.StaticSite:
+		details, err := svc.ServiceDetails.AsStaticSiteDetails()
+		if err != nil {
+			return "", err
+		}
+		serviceURL = details.Url
+	default:
+		return "", fmt.Errorf("custom domains are only supported for web services and static sites")
+	}
+
+	if serviceURL == "" {
+		return "", errors.New("service does not have a public URL yet")
+	}
+
+	u, err := url.Parse(serviceURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse service URL: %w", err)
+	}
+	if u.Host == "" {
+		return serviceURL, nil
+	}
+	return u.Host, nil
+}
+
+// ExpectedRecords returns the records the domain needs. Apex domains can't use a CNAME, so they get an A record
+// pointing at Render's load balancer. Subdomains get a CNAME to the service's onrender.com hostname.
+func ExpectedRecords(d *client.CustomDomain, targetHost string) []Record {
+	if d.DomainType == client.CustomDomainDomainTypeApex {
+		return []Record{{Type: RecordTypeA, Name: d.Name, Value: RenderApexIP}}
+	}
+	return []Record{{Type: RecordTypeCNAME, Name: d.Name, Value: targetHost}}
+}
+
+// CheckRecords looks up each record in public DNS and reports whether it matches the expected value
+func CheckRecords(ctx context.Context, resolver Resolver, records []Record) []RecordCheck {
+	checks := make([]RecordCheck, 0, len(records))
+	for _, record := range records {
+		checks = append(checks, checkRecord(ctx, resolver, record))
+	}
+	return checks
+}
+
+// AllOK returns true if every check matched
+func AllOK(checks []RecordCheck) bool {
+	for _, check := range checks {
+		if !check.OK {
+			return false
+		}
+	}
+	return true
+}
+
+func checkRecord(ctx context.Context, resolver Resolver, record Record) RecordCheck {
+	check := RecordCheck{Record: record}
+
+	switch record.Type {
+	case RecordTypeA:
+		addrs, err := resolver.LookupHost(ctx, record.Name)
+		if err != nil {
+			check.Error = err.Error()
+			return check
+		}
+		check.Actual = addrs
+		check.OK = slices.Contains(addrs, record.Value)
+	case RecordTypeCNAME:
+		cname, err := resolver.LookupCNAME(ctx, record.Name)
+		if err != nil {
+			check.Error = err.Error()
+			return check
+		}
+		cname = normalizeHost(cname)
+		check.Actual = []string{cname}
+		if cname == normalizeHost(record.Value) {
+			check.OK = true
+			return check
+		}
+
+		// LookupCNAME returns the name itself when there is no CNAME. The provider may be flattening the
+		// record (ALIAS/ANAME), so accept it if it resolves to the same addresses as the target.
+		if cname == normalizeHost(record.Name) {
+			check.OK = resolvesToSameAddrs(ctx, resolver, record.Name, record.Value)
+		}
+	default:
+		check.Error = fmt.Sprintf("unsupported record type %s", record.Type)
+	}
+
+	return check
+}
+
+func resolvesToSameAddrs(ctx context.Context, resolver Resolver, host, target string) bool {
+	hostAddrs, err := resolver.LookupHost(ctx, host)
+	if err != nil || len(hostAddrs) == 0 {
+		return false
+	}
+	targetAddrs, err := resolver.LookupHost(ctx, target)
+	if err != nil {
+		return false
+	}
+
+	for _, addr := range hostAddrs {
+		if !slices.Contains(targetAddrs, addr) {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
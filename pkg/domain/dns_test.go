@@ -0,0 +1,111 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/domain"
+)
+
+type fakeResolver struct {
+	cnames map[string]string
+	hosts  map[string][]string
+}
+
+func (r fakeResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	if cname, ok := r.cnames[host]; ok {
+		return cname, nil
+	}
+	if _, ok := r.hosts[host]; ok {
+		return host + ".", nil
+	}
+	return "", errors.New("no such host")
+}
+
+func (r fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addrs, ok := r.hosts[host]; ok {
+		return addrs, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func TestExpectedRecords(t *testing.T) {
+	t.Run("apex domains use an A record", func(t *testing.T) {
+		records := domain.ExpectedRecords(&client.CustomDomain{Name: "example.com", DomainType: client.CustomDomainDomainTypeApex}, "my-app.onrender.com")
+		assert.Equal(t, []domain.Record{{Type: domain.RecordTypeA, Name: "example.com", Value: domain.RenderApexIP}}, records)
+	})
+
+	t.Run("subdomains use a CNAME", func(t *testing.T) {
+		records := domain.ExpectedRecords(&client.CustomDomain{Name: "www.example.com", DomainType: client.CustomDomainDomainTypeSubdomain}, "my-app.onrender.com")
+		assert.Equal(t, []domain.Record{{Type: domain.RecordTypeCNAME, Name: "www.example.com", Value: "my-app.onrender.com"}}, records)
+	})
+}
+
+func TestCheckRecords(t *testing.T) {
+	ctx := context.Background()
+	resolver := fakeResolver{
+		cnames: map[string]string{
+			"www.example.com":  "My-App.onrender.com.",
+			"blog.example.com": "other.example.net.",
+		},
+		hosts: map[string][]string{
+			"example.com":         {domain.RenderApexIP},
+			"wrong.com":           {"1.2.3.4"},
+			"flat.example.com":    {"10.0.0.1"},
+			"my-app.onrender.com": {"10.0.0.1", "10.0.0.2"},
+		},
+	}
+
+	tests := map[string]struct {
+		record domain.Record
+		ok     bool
+		actual []string
+	}{
+		"matching A record": {
+			record: domain.Record{Type: domain.RecordTypeA, Name: "example.com", Value: domain.RenderApexIP},
+			ok:     true,
+			actual: []string{domain.RenderApexIP},
+		},
+		"mismatched A record": {
+			record: domain.Record{Type: domain.RecordTypeA, Name: "wrong.com", Value: domain.RenderApexIP},
+			actual: []string{"1.2.3.4"},
+		},
+		"matching CNAME ignores case and trailing dot": {
+			record: domain.Record{Type: domain.RecordTypeCNAME, Name: "www.example.com", Value: "my-app.onrender.com"},
+			ok:     true,
+			actual: []string{"my-app.onrender.com"},
+		},
+		"mismatched CNAME": {
+			record: domain.Record{Type: domain.RecordTypeCNAME, Name: "blog.example.com", Value: "my-app.onrender.com"},
+			actual: []string{"other.example.net"},
+		},
+		"flattened CNAME resolving to the target": {
+			record: domain.Record{Type: domain.RecordTypeCNAME, Name: "flat.example.com", Value: "my-app.onrender.com"},
+			ok:     true,
+			actual: []string{"flat.example.com"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			checks := domain.CheckRecords(ctx, resolver, []domain.Record{tc.record})
+			require.Len(t, checks, 1)
+			assert.Equal(t, tc.ok, checks[0].OK)
+			assert.Equal(t, tc.actual, checks[0].Actual)
+			assert.Empty(t, checks[0].Error)
+		})
+	}
+
+	t.Run("lookup errors are reported", func(t *testing.T) {
+		checks := domain.CheckRecords(ctx, resolver, []domain.Record{{Type: domain.RecordTypeA, Name: "missing.com", Value: domain.RenderApexIP}})
+		require.Len(t, checks, 1)
+		assert.False(t, checks[0].OK)
+		assert.NotEmpty(t, checks[0].Error)
+		assert.False(t, domain.AllOK(checks))
+	})
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+func (r *Repo) ListCustomDomains(ctx context.Context, serviceID string, params *client.ListCustomDomainsParams) ([]*client.CustomDomain, error) {
+	return client.ListAll(ctx, params, func(ctx context.Context, params *client.ListCustomDomainsParams) ([]*client.CustomDomain, *client.Cursor, error) {
+		return r.listPage(ctx, serviceID, params)
+	})
+}
+
+func (r *Repo) listPage(ctx context.Context, serviceID string, params *client.ListCustomDomainsParams) ([]*client.CustomDomain, *client.Cursor, error) {
+	resp, err := r.client.ListCustomDomainsWithResponse(ctx, serviceID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	domains := make([]*client.CustomDomain, 0, len(res))
+	for _, domainWithCursor := range res {
+		domains = append(domains, &domainWithCursor.CustomDomain)
+	}
+
+	return domains, &res[len(res)-1].Cursor, nil
+}
+
+// CreateCustomDomain adds a domain to the service. The API may return more than one domain, for example
+// adding an apex domain also adds the www subdomain that redirects to it.
+func (r *Repo) CreateCustomDomain(ctx context.Context, serviceID, name string) ([]client.CustomDomain, error) {
+	resp, err := r.client.CreateCustomDomainWithResponse(ctx, serviceID, client.CreateCustomDomainJSONRequestBody{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON201, nil
+}
+
+func (r *Repo) GetCustomDomain(ctx context.Context, serviceID, idOrName string) (*client.CustomDomain, error) {
+	resp, err := r.client.RetrieveCustomDomainWithResponse(ctx, serviceID, idOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteCustomDomain(ctx context.Context, serviceID, idOrName string) error {
+	resp, err := r.client.DeleteCustomDomainWithResponse(ctx, serviceID, idOrName)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// RefreshCustomDomain asks Render to re-check the DNS records of an unverified domain
+func (r *Repo) RefreshCustomDomain(ctx context.Context, serviceID, idOrName string) error {
+	resp, err := r.client.RefreshCustomDomainWithResponse(ctx, serviceID, idOrName)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+// WithRecords pairs a domain with the DNS records that need to exist for it to be verified
+type WithRecords struct {
+	Domain  *client.CustomDomain `json:"domain"`
+	Records []Record             `json:"records"`
+}
+
+// Verification is the result of checking and verifying a domain
+type Verification struct {
+	Domain *client.CustomDomain `json:"domain"`
+	Checks []RecordCheck        `json:"checks"`
+}
+
+func Header() []string {
+	return []string{"Name", "Type", "Status", "Redirects To", "ID"}
+}
+
+func Row(d *client.CustomDomain) []string {
+	return []string{
+		d.Name,
+		string(d.DomainType),
+		string(d.VerificationStatus),
+		d.RedirectForName,
+		d.Id,
+	}
+}
+
+// Instructions lists each added domain followed by the DNS records to create for it
+func Instructions(v []WithRecords) string {
+	var lines []string
+	for _, d := range v {
+		lines = append(lines, fmt.Sprintf("Added %s (%s). Create the following DNS records with your DNS provider:", d.Domain.Name, d.Domain.DomainType))
+		lines = append(lines, "")
+		lines = append(lines, fmt.Sprintf("  %-6s %-40s %s", "TYPE", "NAME", "VALUE"))
+		for _, r := range d.Records {
+			lines = append(lines, fmt.Sprintf("  %-6s %-40s %s", r.Type, r.Name, r.Value))
+		}
+		if d.Domain.DomainType == client.CustomDomainDomainTypeApex {
+			lines = append(lines, "", "If your DNS provider supports ALIAS, ANAME or CNAME flattening, you can point one of those at the")
+			lines = append(lines, "service's onrender.com host instead of using the A record.")
+		}
+		lines = append(lines, "")
+	}
+	lines = append(lines, "DNS changes can take a while to propagate. Once the records are in place, run `render domains verify`.")
+	return strings.Join(lines, "\n")
+}
+
+// VerificationDetails shows the local DNS checks followed by the verification status of the domain
+func VerificationDetails(v *Verification) string {
+	var lines []string
+	if len(v.Checks) > 0 {
+		lines = append(lines, Checks(v.Checks), "")
+	}
+	lines = append(lines, fmt.Sprintf("Domain %s is %s", v.Domain.Name, v.Domain.VerificationStatus))
+	return strings.Join(lines, "\n")
+}
+
+// Checks formats expected and actual DNS records side by side
+func Checks(checks []RecordCheck) string {
+	lines := []string{fmt.Sprintf("  %-6s %-40s %-40s %s", "TYPE", "NAME", "EXPECTED", "ACTUAL")}
+	for _, c := range checks {
+		row := CheckRow(c)
+		lines = append(lines, fmt.Sprintf("  %-6s %-40s %-40s %s", row[0], row[1], row[2], row[3]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func CheckRow(c RecordCheck) []string {
+	actual := strings.Join(c.Actual, ", ")
+	if c.Error != "" {
+		actual = c.Error
+	}
+	if actual == "" {
+		actual = "(none)"
+	}
+	return []string{c.Record.Type, c.Record.Name, c.Record.Value, actual}
+}
+
+func IsVerified(d *client.CustomDomain) bool {
+	return d.VerificationStatus == client.CustomDomainVerificationStatusVerified
+}
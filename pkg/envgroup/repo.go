@@ -0,0 +1,66 @@
+package envgroup
+
+import (
+	"context"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListEnvGroups lists env groups in the current workspace. The list only includes which services each group is
+// linked to, use GetEnvGroup to fetch the env vars of a group.
+func (r *Repo) ListEnvGroups(ctx context.Context, params *client.ListEnvGroupsParams) ([]*client.EnvGroupMeta, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+// listPage returns a page of env groups. The generated type doesn't include the cursor of each group, so it is read
+// from the response body.
+func (r *Repo) listPage(ctx context.Context, params *client.ListEnvGroupsParams) ([]*client.EnvGroupMeta, *client.Cursor, error) {
+	resp, err := r.client.ListEnvGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	groups := make([]*client.EnvGroupMeta, 0, len(*resp.JSON200))
+	for i := range *resp.JSON200 {
+		groups = append(groups, &(*resp.JSON200)[i])
+	}
+	return groups, client.LastCursor(resp.Body), nil
+}
+
+func (r *Repo) GetEnvGroup(ctx context.Context, id string) (*client.EnvGroup, error) {
+	resp, err := r.client.RetrieveEnvGroupWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -0,0 +1,27 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+var ProtectedStatuses = []string{string(client.Protected), string(client.Unprotected)}
+
+// ParseProtectedStatus returns nil for an empty status so it is left unchanged in PATCH requests
+func ParseProtectedStatus(s string) (*client.ProtectedStatus, error) {
+	switch s {
+	case "":
+		return nil, nil
+	case string(client.Protected), string(client.Unprotected):
+		status := client.ProtectedStatus(s)
+		return &status, nil
+	default:
+		return nil, fmt.Errorf("invalid protected status %q, must be one of %v", s, ProtectedStatuses)
+	}
+}
+
+// ResourceCount returns the number of services, datastores and environment groups in the environment
+func ResourceCount(env *client.Environment) int {
+	return len(env.ServiceIds) + len(env.DatabasesIds) + len(env.RedisIds) + len(env.EnvGroupIds)
+}
@@ -0,0 +1,39 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/environment"
+)
+
+func TestParseProtectedStatus(t *testing.T) {
+	t.Run("empty is unchanged", func(t *testing.T) {
+		status, err := environment.ParseProtectedStatus("")
+		require.NoError(t, err)
+		require.Nil(t, status)
+	})
+
+	t.Run("valid status", func(t *testing.T) {
+		status, err := environment.ParseProtectedStatus("protected")
+		require.NoError(t, err)
+		require.Equal(t, client.Protected, *status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, err := environment.ParseProtectedStatus("locked")
+		require.Error(t, err)
+	})
+}
+
+func TestResourceCount(t *testing.T) {
+	env := &client.Environment{
+		ServiceIds:   []string{"srv-1", "srv-2"},
+		DatabasesIds: []string{"dpg-1"},
+		RedisIds:     []string{},
+		EnvGroupIds:  []string{"evg-1"},
+	}
+	require.Equal(t, 4, environment.ResourceCount(env))
+}
@@ -57,3 +57,55 @@ func (e *Repo) listPage(ctx context.Context, params *client.ListEnvironmentsPara
 
 	return envs, &res[len(res)-1].Cursor, nil
 }
+
+func (e *Repo) CreateEnvironment(ctx context.Context, input client.EnvironmentPOSTInput) (*client.Environment, error) {
+	resp, err := e.client.CreateEnvironmentWithResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (e *Repo) UpdateEnvironment(ctx context.Context, id string, input client.EnvironmentPATCHInput) (*client.Environment, error) {
+	resp, err := e.client.UpdateEnvironmentWithResponse(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (e *Repo) DeleteEnvironment(ctx context.Context, id string) error {
+	resp, err := e.client.DeleteEnvironmentWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// AddResources moves services and datastores into the environment. Resources in another environment are removed
+// from it.
+func (e *Repo) AddResources(ctx context.Context, id string, resourceIDs []string) (*client.Environment, error) {
+	resp, err := e.client.AddResourcesToEnvironmentWithResponse(ctx, id, client.EnvironmentResourcesPOSTInput{
+		ResourceIds: resourceIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
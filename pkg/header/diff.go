@@ -0,0 +1,91 @@
+package header
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+const (
+	ChangeAdd    = "add"
+	ChangeRemove = "remove"
+	ChangeUpdate = "update"
+)
+
+// Change is a difference between the header rules on a service and the rules being applied
+type Change struct {
+	Type     string `json:"type"`
+	Path     string `json:"path"`
+	Name     string `json:"name"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// Diff compares the existing rules with the desired rules. Rules are matched by path and case-insensitive name.
+func Diff(existing []*client.Header, desired []client.HeaderInput) []Change {
+	key := func(path, name string) string {
+		return path + "\x00" + strings.ToLower(name)
+	}
+
+	current := map[string]*client.Header{}
+	var changes []Change
+	for _, h := range existing {
+		k := key(h.Path, h.Name)
+		if _, ok := current[k]; ok {
+			// duplicate rules collapse into one when replaced
+			changes = append(changes, Change{Type: ChangeRemove, Path: h.Path, Name: h.Name, OldValue: h.Value})
+			continue
+		}
+		current[k] = h
+	}
+
+	seen := map[string]bool{}
+	for _, h := range desired {
+		k := key(h.Path, h.Name)
+		seen[k] = true
+
+		old, ok := current[k]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Type: ChangeAdd, Path: h.Path, Name: h.Name, NewValue: h.Value})
+		case old.Value != h.Value || old.Name != h.Name:
+			changes = append(changes, Change{Type: ChangeUpdate, Path: h.Path, Name: h.Name, OldValue: old.Value, NewValue: h.Value})
+		}
+	}
+
+	for k, h := range current {
+		if !seen[k] {
+			changes = append(changes, Change{Type: ChangeRemove, Path: h.Path, Name: h.Name, OldValue: h.Value})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+		return strings.ToLower(changes[i].Name) < strings.ToLower(changes[j].Name)
+	})
+	return changes
+}
+
+// FormatDiff renders changes as + (add), - (remove) and ~ (update) lines
+func FormatDiff(changes []Change) string {
+	if len(changes) == 0 {
+		return "No changes"
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		switch c.Type {
+		case ChangeAdd:
+			lines = append(lines, fmt.Sprintf("+ %s  %s: %s", c.Path, c.Name, c.NewValue))
+		case ChangeRemove:
+			lines = append(lines, fmt.Sprintf("- %s  %s: %s", c.Path, c.Name, c.OldValue))
+		case ChangeUpdate:
+			lines = append(lines, fmt.Sprintf("~ %s  %s: %s -> %s", c.Path, c.Name, c.OldValue, c.NewValue))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,81 @@
+package header_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/rulefile"
+)
+
+func TestParseNetlify(t *testing.T) {
+	data := []byte(`
+# security headers
+/*
+  X-Frame-Options: DENY
+  Strict-Transport-Security: max-age=63072000; includeSubDomains
+  Cache-Control: public
+  Cache-Control: max-age=3600
+
+/assets/*
+  # long cache for fingerprinted assets
+  Cache-Control: public, max-age=31536000, immutable
+`)
+
+	headers, err := header.Parse(rulefile.FormatNetlify, data)
+	require.NoError(t, err)
+
+	assert.Equal(t, []client.HeaderInput{
+		{Path: "/*", Name: "X-Frame-Options", Value: "DENY"},
+		{Path: "/*", Name: "Strict-Transport-Security", Value: "max-age=63072000; includeSubDomains"},
+		{Path: "/*", Name: "Cache-Control", Value: "public, max-age=3600"},
+		{Path: "/assets/*", Name: "Cache-Control", Value: "public, max-age=31536000, immutable"},
+	}, headers)
+}
+
+func TestParseNetlifyErrors(t *testing.T) {
+	_, err := header.Parse(rulefile.FormatNetlify, []byte("  X-Frame-Options: DENY\n"))
+	assert.ErrorContains(t, err, "line 1: header is not under a path")
+
+	_, err = header.Parse(rulefile.FormatNetlify, []byte("/*\n  X-Frame-Options DENY\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestParseYAML(t *testing.T) {
+	headers, err := header.Parse(rulefile.FormatYAML, []byte(`
+headers:
+  - path: /*
+    name: X-Frame-Options
+    value: DENY
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []client.HeaderInput{{Path: "/*", Name: "X-Frame-Options", Value: "DENY"}}, headers)
+
+	_, err = header.Parse(rulefile.FormatJSON, []byte(`[{"path": "nope", "name": "X-Test", "value": "1"}]`))
+	assert.ErrorContains(t, err, "header 1")
+}
+
+func TestDiff(t *testing.T) {
+	existing := []*client.Header{
+		{Id: "hdr-1", Path: "/*", Name: "X-Frame-Options", Value: "SAMEORIGIN"},
+		{Id: "hdr-2", Path: "/*", Name: "X-Old", Value: "1"},
+		{Id: "hdr-3", Path: "/assets/*", Name: "Cache-Control", Value: "public"},
+	}
+	desired := []client.HeaderInput{
+		{Path: "/*", Name: "x-frame-options", Value: "DENY"},
+		{Path: "/*", Name: "Strict-Transport-Security", Value: "max-age=63072000"},
+		{Path: "/assets/*", Name: "Cache-Control", Value: "public"},
+	}
+
+	changes := header.Diff(existing, desired)
+	assert.Equal(t, []header.Change{
+		{Type: header.ChangeAdd, Path: "/*", Name: "Strict-Transport-Security", NewValue: "max-age=63072000"},
+		{Type: header.ChangeUpdate, Path: "/*", Name: "x-frame-options", OldValue: "SAMEORIGIN", NewValue: "DENY"},
+		{Type: header.ChangeRemove, Path: "/*", Name: "X-Old", OldValue: "1"},
+	}, changes)
+
+	assert.Equal(t, "No changes", header.FormatDiff(header.Diff(existing[2:], desired[2:])))
+}
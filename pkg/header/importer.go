@@ -0,0 +1,112 @@
+package header
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/rulefile"
+)
+
+// Rule is a header rule as declared in a YAML or JSON file. It matches the headers section of a static site in
+// render.yaml, so rules can be copied from one to the other.
+type Rule struct {
+	Path  string `yaml:"path" json:"path"`
+	Name  string `yaml:"name" json:"name"`
+	Value string `yaml:"value" json:"value"`
+}
+
+// Parse reads header rules in the given format
+func Parse(format string, data []byte) ([]client.HeaderInput, error) {
+	switch format {
+	case rulefile.FormatNetlify:
+		return parseNetlify(data)
+	case rulefile.FormatYAML, rulefile.FormatJSON:
+		return parseYAML(data)
+	}
+	return nil, rulefile.UnsupportedFormatError(format)
+}
+
+func parseYAML(data []byte) ([]client.HeaderInput, error) {
+	rules, err := rulefile.LoadList[Rule](data, "headers")
+	if err != nil {
+		return nil, err
+	}
+
+	var res []client.HeaderInput
+	for i, rule := range rules {
+		if err := validate(rule); err != nil {
+			return nil, fmt.Errorf("header %d: %w", i+1, err)
+		}
+		res = append(res, client.HeaderInput{Path: rule.Path, Name: rule.Name, Value: rule.Value})
+	}
+	return res, nil
+}
+
+func validate(rule Rule) error {
+	if !strings.HasPrefix(rule.Path, "/") {
+		return fmt.Errorf("path must start with /, got %q", rule.Path)
+	}
+	if rule.Name == "" {
+		return errors.New("name is required")
+	}
+	if strings.ContainsAny(rule.Name, " :") {
+		return fmt.Errorf("invalid header name %q", rule.Name)
+	}
+	return nil
+}
+
+// parseNetlify reads a Netlify _headers file. Paths start at the beginning of a line and are followed by indented
+// "Name: Value" lines. A header repeated for the same path is combined into a single comma separated value, the
+// same way Netlify does.
+func parseNetlify(data []byte) ([]client.HeaderInput, error) {
+	var res []client.HeaderInput
+	index := map[string]int{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNum := 0
+	path := ""
+	for scanner.Scan() {
+		lineNum++
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if raw[0] != ' ' && raw[0] != '\t' {
+			path = line
+			continue
+		}
+
+		if path == "" {
+			return nil, fmt.Errorf("line %d: header is not under a path: %s", lineNum, line)
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"Name: Value\": %s", lineNum, line)
+		}
+
+		rule := Rule{Path: path, Name: strings.TrimSpace(name), Value: strings.TrimSpace(value)}
+		if err := validate(rule); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+
+		key := rule.Path + "\x00" + strings.ToLower(rule.Name)
+		if i, ok := index[key]; ok {
+			res[i].Value += ", " + rule.Value
+			continue
+		}
+		index[key] = len(res)
+		res = append(res, client.HeaderInput{Path: rule.Path, Name: rule.Name, Value: rule.Value})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
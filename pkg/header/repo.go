@@ -0,0 +1,98 @@
+package header
+
+import (
+	"context"
+	"sort"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListHeaders returns the header rules of a static site sorted by path and name
+func (r *Repo) ListHeaders(ctx context.Context, serviceID string, params *client.ListHeadersParams) ([]*client.Header, error) {
+	headers, err := client.ListAll(ctx, params, func(ctx context.Context, params *client.ListHeadersParams) ([]*client.Header, *client.Cursor, error) {
+		return r.listPage(ctx, serviceID, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(headers, func(i, j int) bool {
+		if headers[i].Path != headers[j].Path {
+			return headers[i].Path < headers[j].Path
+		}
+		return headers[i].Name < headers[j].Name
+	})
+	return headers, nil
+}
+
+func (r *Repo) listPage(ctx context.Context, serviceID string, params *client.ListHeadersParams) ([]*client.Header, *client.Cursor, error) {
+	resp, err := r.client.ListHeadersWithResponse(ctx, serviceID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	headers := make([]*client.Header, 0, len(res))
+	for _, headerWithCursor := range res {
+		headers = append(headers, &headerWithCursor.Header)
+	}
+
+	return headers, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) AddHeader(ctx context.Context, serviceID string, data client.HeaderInput) (*client.Header, error) {
+	resp, err := r.client.AddHeadersWithResponse(ctx, serviceID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, nil
+	}
+
+	return resp.JSON201.Headers, nil
+}
+
+// PutHeaders replaces all the header rules of a static site with the given rules
+func (r *Repo) PutHeaders(ctx context.Context, serviceID string, data []client.HeaderInput) ([]client.Header, error) {
+	resp, err := r.client.UpdateHeadersWithResponse(ctx, serviceID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) DeleteHeader(ctx context.Context, serviceID, headerID string) error {
+	resp, err := r.client.DeleteHeaderWithResponse(ctx, serviceID, headerID)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
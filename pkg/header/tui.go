@@ -0,0 +1,35 @@
+package header
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+// ApplyResult is the set of rules on the service after an apply, along with the changes that were made
+type ApplyResult struct {
+	Headers []client.Header `json:"headers"`
+	Changes []Change        `json:"changes"`
+	DryRun  bool            `json:"dryRun"`
+}
+
+func Header() []string {
+	return []string{"Path", "Name", "Value", "ID"}
+}
+
+func Row(h *client.Header) []string {
+	return []string{h.Path, h.Name, h.Value, h.Id}
+}
+
+// ApplySummary describes the changes an apply made, or would make for a dry run
+func ApplySummary(serviceID string, res *ApplyResult) string {
+	var lines []string
+	if res.DryRun {
+		lines = append(lines, fmt.Sprintf("Dry run: the following changes would be made to header rules for service %s", serviceID))
+	} else {
+		lines = append(lines, fmt.Sprintf("Replaced header rules for service %s with %d rules", serviceID, len(res.Headers)))
+	}
+	lines = append(lines, "", FormatDiff(res.Changes))
+	return strings.Join(lines, "\n")
+}
@@ -1,9 +1,34 @@
 package job
 
-import client "github.com/renderinc/cli/pkg/client/jobs"
+import (
+	"time"
+
+	"github.com/renderinc/cli/pkg/backoff"
+	client "github.com/renderinc/cli/pkg/client/jobs"
+)
+
+// PollBackoff spaces out polls of a running job, so short jobs finish quickly and long jobs don't hit rate limits
+var PollBackoff = backoff.Exponential{Min: 2 * time.Second, Max: 30 * time.Second}
 
 // IsCancellable returns true if the job is cancellable. JobStatus only contains terminal values, so
 // a nil value indicates that the job is cancellable.
 func IsCancellable(status *client.JobStatus) bool {
 	return status == nil
 }
+
+// IsComplete returns true if the job has finished, failed or was canceled
+func IsComplete(status *client.JobStatus) bool {
+	return status != nil
+}
+
+func IsSuccessful(status *client.JobStatus) bool {
+	return status != nil && *status == client.Succeeded
+}
+
+// LogsStartTime returns when the job's logs begin, so waiting on a job that is already running doesn't miss them
+func LogsStartTime(j *client.Job) time.Time {
+	if j.StartedAt != nil {
+		return *j.StartedAt
+	}
+	return j.CreatedAt
+}
@@ -0,0 +1,33 @@
+package job_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	client "github.com/renderinc/cli/pkg/client/jobs"
+	"github.com/renderinc/cli/pkg/job"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func TestIsComplete(t *testing.T) {
+	assert.False(t, job.IsComplete(nil))
+	assert.True(t, job.IsComplete(pointers.From(client.Failed)))
+	assert.True(t, job.IsComplete(pointers.From(client.Succeeded)))
+}
+
+func TestIsSuccessful(t *testing.T) {
+	assert.False(t, job.IsSuccessful(nil))
+	assert.False(t, job.IsSuccessful(pointers.From(client.Canceled)))
+	assert.False(t, job.IsSuccessful(pointers.From(client.Failed)))
+	assert.True(t, job.IsSuccessful(pointers.From(client.Succeeded)))
+}
+
+func TestLogsStartTime(t *testing.T) {
+	created := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	started := created.Add(30 * time.Second)
+
+	assert.Equal(t, created, job.LogsStartTime(&client.Job{CreatedAt: created}))
+	assert.Equal(t, started, job.LogsStartTime(&client.Job{CreatedAt: created, StartedAt: &started}))
+}
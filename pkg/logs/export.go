@@ -0,0 +1,99 @@
+package logs
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+)
+
+type ExportFormat string
+
+const (
+	ExportNDJSON ExportFormat = "ndjson"
+	ExportCSV    ExportFormat = "csv"
+	ExportText   ExportFormat = "txt"
+)
+
+// SplitByValues are the labels logs can be split into separate files by
+var SplitByValues = []string{"resource", "instance"}
+
+// ExportLabels are the labels written as CSV columns, in order
+var ExportLabels = []lclient.LogLabelName{
+	lclient.LogLabelNameResource,
+	lclient.LogLabelNameInstance,
+	lclient.LogLabelNameLevel,
+	lclient.LogLabelNameType,
+	lclient.LogLabelNameHost,
+	lclient.LogLabelNameMethod,
+	lclient.LogLabelNamePath,
+	lclient.LogLabelNameStatusCode,
+}
+
+// ParseExportPath returns the export format for path from its extension, and whether it should be gzipped
+func ParseExportPath(path string) (ExportFormat, bool, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	gzip := ext == ".gz"
+	if gzip {
+		ext = strings.ToLower(filepath.Ext(strings.TrimSuffix(path, filepath.Ext(path))))
+	}
+
+	switch ext {
+	case ".ndjson", ".jsonl":
+		return ExportNDJSON, gzip, nil
+	case ".csv":
+		return ExportCSV, gzip, nil
+	case ".txt", ".log":
+		return ExportText, gzip, nil
+	}
+	return "", false, fmt.Errorf("unsupported export file %q: use a .ndjson, .csv or .txt extension, optionally followed by .gz", path)
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// SplitPath returns the path of the file for logs with the given label value, e.g. incident-srv-123.ndjson.gz for
+// incident.ndjson.gz
+func SplitPath(path, value string) string {
+	if value == "" {
+		value = "unknown"
+	}
+	value = unsafeFileChars.ReplaceAllString(value, "-")
+
+	dir, file := filepath.Split(path)
+	ext := filepath.Ext(file)
+	if strings.EqualFold(ext, ".gz") {
+		ext = filepath.Ext(strings.TrimSuffix(file, ext)) + ext
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s%s", strings.TrimSuffix(file, ext), value, ext))
+}
+
+// LabelValue returns the value of the named label, or an empty string if the log doesn't have it
+func LabelValue(log *lclient.Log, name lclient.LogLabelName) string {
+	for _, label := range log.Labels {
+		if label.Name == name {
+			return label.Value
+		}
+	}
+	return ""
+}
+
+// CSVHeader returns the header row matching CSVRecord
+func CSVHeader() []string {
+	header := []string{"timestamp", "id"}
+	for _, name := range ExportLabels {
+		header = append(header, string(name))
+	}
+	return append(header, "message")
+}
+
+// CSVRecord returns the log as a CSV row with a column for each of ExportLabels
+func CSVRecord(log *lclient.Log) []string {
+	record := []string{log.Timestamp.Format(time.RFC3339Nano), log.Id}
+	for _, name := range ExportLabels {
+		record = append(record, LabelValue(log, name))
+	}
+	return append(record, log.Message)
+}
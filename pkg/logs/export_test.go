@@ -0,0 +1,77 @@
+package logs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
+)
+
+func TestParseExportPath(t *testing.T) {
+	tcs := []struct {
+		path   string
+		format logs.ExportFormat
+		gzip   bool
+	}{
+		{path: "incident.ndjson", format: logs.ExportNDJSON},
+		{path: "incident.jsonl.gz", format: logs.ExportNDJSON, gzip: true},
+		{path: "out/incident.CSV", format: logs.ExportCSV},
+		{path: "incident.csv.gz", format: logs.ExportCSV, gzip: true},
+		{path: "incident.txt", format: logs.ExportText},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.path, func(t *testing.T) {
+			format, gzip, err := logs.ParseExportPath(tc.path)
+			require.NoError(t, err)
+			require.Equal(t, tc.format, format)
+			require.Equal(t, tc.gzip, gzip)
+		})
+	}
+
+	t.Run("rejects unknown extensions", func(t *testing.T) {
+		_, _, err := logs.ParseExportPath("incident.json.gz")
+		require.Error(t, err)
+		_, _, err = logs.ParseExportPath("incident")
+		require.Error(t, err)
+	})
+}
+
+func TestSplitPath(t *testing.T) {
+	require.Equal(t, "incident-srv-123.ndjson", logs.SplitPath("incident.ndjson", "srv-123"))
+	require.Equal(t, "out/incident.2024-srv-123.csv.gz", logs.SplitPath("out/incident.2024.csv.gz", "srv-123"))
+	require.Equal(t, "incident-unknown.txt", logs.SplitPath("incident.txt", ""))
+	require.Equal(t, "incident-a-b.txt", logs.SplitPath("incident.txt", "a/b"))
+}
+
+func TestCSVRecord(t *testing.T) {
+	log := &lclient.Log{
+		Id:        "log-1",
+		Timestamp: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Message:   "GET /health",
+		Labels: []lclient.LogLabel{
+			{Name: lclient.LogLabelNameStatusCode, Value: "200"},
+			{Name: lclient.LogLabelNameResource, Value: "srv-123"},
+			{Name: lclient.LogLabelNameLevel, Value: "info"},
+		},
+	}
+
+	header := logs.CSVHeader()
+	record := logs.CSVRecord(log)
+	require.Len(t, record, len(header))
+
+	row := map[string]string{}
+	for i, name := range header {
+		row[name] = record[i]
+	}
+	require.Equal(t, "2024-01-01T00:00:00Z", row["timestamp"])
+	require.Equal(t, "log-1", row["id"])
+	require.Equal(t, "srv-123", row["resource"])
+	require.Equal(t, "info", row["level"])
+	require.Equal(t, "200", row["statusCode"])
+	require.Equal(t, "", row["instance"])
+	require.Equal(t, "GET /health", row["message"])
+}
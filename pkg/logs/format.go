@@ -0,0 +1,184 @@
+package logs
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/style"
+)
+
+// responseTimeLabel is the request log label with the response time in milliseconds. It isn't one of the labels
+// logs can be filtered by, so it has no lclient constant.
+const responseTimeLabel lclient.LogLabelName = "responseTimeMS"
+
+// Fields are the values a --format template can use, e.g. {{.Timestamp.Format "15:04:05"}} {{.Level}} {{.Message}}.
+// Labels without a field are available with {{.Label "name"}}.
+type Fields struct {
+	Timestamp  time.Time
+	ID         string
+	Message    string
+	Resource   string
+	Instance   string
+	Level      string
+	Type       string
+	Host       string
+	Method     string
+	Path       string
+	StatusCode string
+
+	log *lclient.Log
+}
+
+func NewFields(log *lclient.Log) Fields {
+	return Fields{
+		Timestamp:  log.Timestamp,
+		ID:         log.Id,
+		Message:    log.Message,
+		Resource:   LabelValue(log, lclient.LogLabelNameResource),
+		Instance:   LabelValue(log, lclient.LogLabelNameInstance),
+		Level:      LabelValue(log, lclient.LogLabelNameLevel),
+		Type:       LabelValue(log, lclient.LogLabelNameType),
+		Host:       LabelValue(log, lclient.LogLabelNameHost),
+		Method:     LabelValue(log, lclient.LogLabelNameMethod),
+		Path:       LabelValue(log, lclient.LogLabelNamePath),
+		StatusCode: LabelValue(log, lclient.LogLabelNameStatusCode),
+		log:        log,
+	}
+}
+
+func (f Fields) Label(name string) string {
+	return LabelValue(f.log, lclient.LogLabelName(name))
+}
+
+// ParseFormat parses a --format template, which is executed with Fields for each log
+func ParseFormat(format string) (*template.Template, error) {
+	tmpl, err := template.New("format").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log format: %w", err)
+	}
+	return tmpl, nil
+}
+
+// sourceColors tell apart the resources logs come from
+var sourceColors = []lipgloss.AdaptiveColor{
+	style.ColorInfo,
+	style.ColorOK,
+	style.ColorWarningDeprioritized,
+	style.ColorWarning,
+}
+
+type FormatOption func(*Formatter)
+
+// WithSource prefixes each log with the instance or resource that emitted it, for when logs from several resources
+// are shown together
+func WithSource() FormatOption {
+	return func(f *Formatter) {
+		f.showSource = true
+	}
+}
+
+// WithTemplate formats each log with a template from ParseFormat instead of the default columns
+func WithTemplate(tmpl *template.Template) FormatOption {
+	return func(f *Formatter) {
+		f.template = tmpl
+	}
+}
+
+// Formatter renders logs as text, coloring levels and status codes when the renderer's output supports it
+type Formatter struct {
+	renderer   *lipgloss.Renderer
+	showSource bool
+	template   *template.Template
+}
+
+func NewFormatter(renderer *lipgloss.Renderer, opts ...FormatOption) *Formatter {
+	f := &Formatter{renderer: renderer}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Format renders the log as a single line, without a trailing newline
+func (f *Formatter) Format(log *lclient.Log) (string, error) {
+	if f.template != nil {
+		var sb strings.Builder
+		if err := f.template.Execute(&sb, NewFields(log)); err != nil {
+			return "", fmt.Errorf("error formatting log: %w", err)
+		}
+		return sb.String(), nil
+	}
+
+	return strings.Join(f.Columns(log), "  "), nil
+}
+
+// Columns returns the timestamp, the source if enabled, and the message of the log. Request logs show their method,
+// path, status code and response time instead of the raw message.
+func (f *Formatter) Columns(log *lclient.Log) []string {
+	fields := NewFields(log)
+
+	columns := []string{f.renderer.NewStyle().Foreground(style.ColorDeprioritized).Render(log.Timestamp.Format(time.DateTime))}
+	if f.showSource {
+		columns = append(columns, f.source(fields))
+	}
+
+	if fields.Type == "request" && fields.Method != "" {
+		return append(columns, f.request(fields))
+	}
+	return append(columns, f.renderer.NewStyle().Foreground(levelColor(fields.Level)).Render(log.Message))
+}
+
+func (f *Formatter) source(fields Fields) string {
+	source := fields.Instance
+	if source == "" {
+		source = fields.Resource
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(fields.Resource))
+	color := sourceColors[h.Sum32()%uint32(len(sourceColors))]
+
+	return f.renderer.NewStyle().Foreground(color).Render(source)
+}
+
+func (f *Formatter) request(fields Fields) string {
+	parts := []string{
+		f.renderer.NewStyle().Bold(true).Render(fields.Method),
+		fields.Path,
+		f.renderer.NewStyle().Foreground(statusCodeColor(fields.StatusCode)).Render(fields.StatusCode),
+	}
+	if responseTime := fields.Label(string(responseTimeLabel)); responseTime != "" {
+		parts = append(parts, f.renderer.NewStyle().Foreground(style.ColorDeprioritized).Render(responseTime+"ms"))
+	}
+	return strings.Join(parts, " ")
+}
+
+func levelColor(level string) lipgloss.TerminalColor {
+	switch strings.ToLower(level) {
+	case "error", "critical", "alert", "emergency":
+		return style.ColorError
+	case "warning":
+		return style.ColorWarning
+	case "debug":
+		return style.ColorDeprioritized
+	}
+	return lipgloss.NoColor{}
+}
+
+func statusCodeColor(statusCode string) lipgloss.TerminalColor {
+	switch {
+	case strings.HasPrefix(statusCode, "5"):
+		return style.ColorError
+	case strings.HasPrefix(statusCode, "4"):
+		return style.ColorWarning
+	case strings.HasPrefix(statusCode, "2"), strings.HasPrefix(statusCode, "3"):
+		return style.ColorOK
+	}
+	return lipgloss.NoColor{}
+}
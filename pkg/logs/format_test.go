@@ -0,0 +1,76 @@
+package logs_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/require"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
+)
+
+func TestFormatter(t *testing.T) {
+	// renderers that aren't writing to a terminal don't add colors
+	renderer := lipgloss.NewRenderer(io.Discard)
+
+	appLog := &lclient.Log{
+		Id:        "log-1",
+		Timestamp: time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+		Message:   "something failed",
+		Labels: []lclient.LogLabel{
+			{Name: lclient.LogLabelNameResource, Value: "srv-123"},
+			{Name: lclient.LogLabelNameInstance, Value: "srv-123-abcde"},
+			{Name: lclient.LogLabelNameLevel, Value: "error"},
+			{Name: lclient.LogLabelNameType, Value: "app"},
+		},
+	}
+
+	requestLog := &lclient.Log{
+		Id:        "log-2",
+		Timestamp: time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+		Message:   "raw request line",
+		Labels: []lclient.LogLabel{
+			{Name: lclient.LogLabelNameResource, Value: "srv-123"},
+			{Name: lclient.LogLabelNameType, Value: "request"},
+			{Name: lclient.LogLabelNameMethod, Value: "GET"},
+			{Name: lclient.LogLabelNamePath, Value: "/health"},
+			{Name: lclient.LogLabelNameStatusCode, Value: "503"},
+			{Name: "responseTimeMS", Value: "12"},
+		},
+	}
+
+	t.Run("timestamp and message", func(t *testing.T) {
+		line, err := logs.NewFormatter(renderer).Format(appLog)
+		require.NoError(t, err)
+		require.Equal(t, "2024-01-01 12:30:00  something failed", line)
+	})
+
+	t.Run("with source", func(t *testing.T) {
+		line, err := logs.NewFormatter(renderer, logs.WithSource()).Format(appLog)
+		require.NoError(t, err)
+		require.Equal(t, "2024-01-01 12:30:00  srv-123-abcde  something failed", line)
+	})
+
+	t.Run("request logs", func(t *testing.T) {
+		line, err := logs.NewFormatter(renderer).Format(requestLog)
+		require.NoError(t, err)
+		require.Equal(t, "2024-01-01 12:30:00  GET /health 503 12ms", line)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		tmpl, err := logs.ParseFormat(`{{.Timestamp.Format "15:04"}} {{.Level}} {{.Instance}} {{.Message}} {{.Label "type"}}`)
+		require.NoError(t, err)
+
+		line, err := logs.NewFormatter(renderer, logs.WithTemplate(tmpl)).Format(appLog)
+		require.NoError(t, err)
+		require.Equal(t, "12:30 error srv-123-abcde something failed app", line)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := logs.ParseFormat(`{{.Message`)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,69 @@
+package logs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/config"
+)
+
+// Preset is a saved logs query. Its args are flags of the logs command, e.g. --level=error.
+type Preset struct {
+	Name string   `json:"name"`
+	Args []string `json:"args"`
+}
+
+// Command returns the logs command the preset runs, quoting args so it can be pasted in a shell
+func (p Preset) Command() string {
+	parts := []string{"render logs"}
+	for _, arg := range p.Args {
+		parts = append(parts, shellQuote(arg))
+	}
+	return strings.Join(parts, " ")
+}
+
+func shellQuote(arg string) string {
+	if !strings.ContainsAny(arg, " \t\n'\"`$\\*?[]{}()<>|&;#~") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// ListPresets returns the saved presets sorted by name
+func ListPresets() ([]Preset, error) {
+	saved, err := config.LogPresets()
+	if err != nil {
+		return nil, err
+	}
+
+	presets := make([]Preset, 0, len(saved))
+	for name, args := range saved {
+		presets = append(presets, Preset{Name: name, Args: args})
+	}
+	slices.SortFunc(presets, func(a, b Preset) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return presets, nil
+}
+
+func GetPreset(name string) (Preset, error) {
+	saved, err := config.LogPresets()
+	if err != nil {
+		return Preset{}, err
+	}
+
+	args, ok := saved[name]
+	if !ok {
+		return Preset{}, fmt.Errorf("no log preset named %q, see `render logs presets list`", name)
+	}
+	return Preset{Name: name, Args: args}, nil
+}
+
+func SavePreset(name string, args []string) error {
+	return config.SaveLogPreset(name, args)
+}
+
+func DeletePreset(name string) error {
+	return config.DeleteLogPreset(name)
+}
@@ -0,0 +1,33 @@
+package logs_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/logs"
+)
+
+func TestPresets(t *testing.T) {
+	t.Setenv("RENDER_CLI_CONFIG_PATH", filepath.Join(t.TempDir(), "cli.yaml"))
+
+	require.NoError(t, logs.SavePreset("errors-api", []string{"--resources=srv-1", "--level=error"}))
+	require.NoError(t, logs.SavePreset("api-timeouts", []string{"--text=timed out"}))
+	require.Error(t, logs.SavePreset("bad name", nil))
+
+	presets, err := logs.ListPresets()
+	require.NoError(t, err)
+	require.Len(t, presets, 2)
+	require.Equal(t, "api-timeouts", presets[0].Name)
+	require.Equal(t, "render logs '--text=timed out'", presets[0].Command())
+
+	preset, err := logs.GetPreset("errors-api")
+	require.NoError(t, err)
+	require.Equal(t, []string{"--resources=srv-1", "--level=error"}, preset.Args)
+
+	require.NoError(t, logs.DeletePreset("errors-api"))
+	_, err = logs.GetPreset("errors-api")
+	require.Error(t, err)
+	require.Error(t, logs.DeletePreset("errors-api"))
+}
@@ -3,17 +3,23 @@ package logs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/websocket"
 
 	"github.com/renderinc/cli/pkg/client"
 	lclient "github.com/renderinc/cli/pkg/client/logs"
 	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
 )
 
+// MaxPageSize is the most logs the API returns per request
+const MaxPageSize = 100
+
 func NewLogRepo(c *client.ClientWithResponses) *LogRepo {
 	return &LogRepo{c: c}
 }
@@ -35,13 +41,159 @@ func (l *LogRepo) ListLogs(ctx context.Context, params *client.ListLogsParams) (
 	return logs.JSON200, nil
 }
 
-func (l *LogRepo) TailLogs(ctx context.Context, params *client.ListLogsParams) (<-chan *lclient.Log, error) {
+// ListLogPages calls fn with each page of logs matching params, following the pagination cursors until limit logs
+// were fetched or there are no more. A limit of 0 fetches every page.
+func (l *LogRepo) ListLogPages(ctx context.Context, params *client.ListLogsParams, limit int, fn func(page *client.Logs200Response) error) error {
+	pageParams := *params
+	var count int
+	for {
+		pageSize := MaxPageSize
+		if limit > 0 {
+			pageSize = min(limit-count, MaxPageSize)
+		}
+		pageParams.Limit = pointers.From(pageSize)
+
+		page, err := l.ListLogs(ctx, &pageParams)
+		if err != nil {
+			return err
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		count += len(page.Logs)
+		if !page.HasMore || len(page.Logs) == 0 || (limit > 0 && count >= limit) {
+			return nil
+		}
+		pageParams = *NextPageParams(&pageParams, page)
+	}
+}
+
+// NextPageParams returns params for the page of logs after page
+func NextPageParams(params *client.ListLogsParams, page *client.Logs200Response) *client.ListLogsParams {
+	next := *params
+	next.StartTime = pointers.From(page.NextStartTime)
+	next.EndTime = pointers.From(page.NextEndTime)
+	return &next
+}
+
+// TailLogs streams new logs until ctx is done. The connection is kept alive with pings, and if it drops, TailLogs
+// reconnects with backoff and backfills the logs missed in the meantime before resuming the stream. Connection
+// changes are sent as events alongside the logs. The channel is closed when ctx is done or after a TailFailed event
+// if the API rejects a reconnect.
+func (l *LogRepo) TailLogs(ctx context.Context, params *client.ListLogsParams) (<-chan TailEvent, error) {
+	connectedAt := time.Now()
+	conn, err := dialLogs(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan TailEvent)
+	send := func(evt TailEvent) bool {
+		select {
+		case ch <- evt:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(ch)
+
+		var dedup Dedup
+		var retryIn time.Duration
+		for {
+			received, err := readLogs(ctx, conn, &dedup, send)
+			if ctx.Err() != nil {
+				return
+			}
+			if received {
+				retryIn = 0
+			}
+
+			var backfilled int
+			for {
+				retryIn = reconnectBackoff.Next(retryIn)
+				if !send(Disconnected{Err: err, RetryIn: retryIn}) {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(retryIn):
+				}
+
+				conn, err = dialLogs(params)
+				if errors.Is(err, errPermanent) {
+					send(TailFailed{Err: err})
+					return
+				}
+				if err != nil {
+					continue
+				}
+
+				// the new connection only streams logs from now on, so fetch the ones sent while disconnected
+				since := connectedAt
+				if start := dedup.ResumeFrom(); start != nil {
+					since = *start
+				}
+				backfilled, err = l.backfill(ctx, params, since, &dedup, send)
+				if err == nil {
+					break
+				}
+				conn.Close()
+				if ctx.Err() != nil {
+					return
+				}
+			}
+
+			if !send(Reconnected{Backfilled: backfilled}) {
+				conn.Close()
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// backfill sends the logs matching params from since until now that haven't been sent yet, and returns how many
+// were sent
+func (l *LogRepo) backfill(ctx context.Context, params *client.ListLogsParams, since time.Time, dedup *Dedup, send func(TailEvent) bool) (int, error) {
+	listParams := *params
+	listParams.StartTime = &since
+	listParams.EndTime = pointers.From(time.Now())
+	listParams.Direction = pointers.From(lclient.Forward)
+
+	var count int
+	err := l.ListLogPages(ctx, &listParams, 0, func(page *client.Logs200Response) error {
+		for _, log := range page.Logs {
+			if dedup.Seen(&log) {
+				continue
+			}
+			if !send(LogReceived{Log: &log}) {
+				return ctx.Err()
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+var errPermanent = errors.New("log subscription rejected")
+
+func dialLogs(params *client.ListLogsParams) (*websocket.Conn, error) {
 	subscribeParams := client.SubscribeLogsParams(*params)
 	apiConfig, err := config.DefaultAPIConfig()
 	if err != nil {
 		return nil, err
 	}
 	req, err := client.NewSubscribeLogsRequest(apiConfig.Host, &subscribeParams)
+	if err != nil {
+		return nil, err
+	}
 	dialer := websocket.Dialer{}
 
 	u := req.URL
@@ -57,38 +209,77 @@ func (l *LogRepo) TailLogs(ctx context.Context, params *client.ListLogsParams) (
 				return nil, err
 			}
 
+			// client errors won't succeed on retry, except for rate limits
+			if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+				return nil, fmt.Errorf("%w: failed to tail logs: %s", errPermanent, body)
+			}
 			return nil, fmt.Errorf("failed to tail logs: %s", body)
 		}
 
 		return nil, err
 	}
 
-	ch := make(chan *lclient.Log)
+	return conn, nil
+}
+
+// readLogs sends logs from the connection until the connection drops or ctx is done. It returns whether any new log
+// was received and the error that ended the connection.
+func readLogs(ctx context.Context, conn *websocket.Conn, dedup *Dedup, send func(TailEvent) bool) (bool, error) {
+	done := make(chan struct{})
+	defer close(done)
+	defer conn.Close()
 
-	// Read messages from the WebSocket connection
-	go func(ctx context.Context) {
-		defer conn.Close()
-		defer close(ch)
+	// a connection that stops responding to pings is treated as dropped instead of hanging forever
+	extendDeadline := func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	}
+	if err := extendDeadline(""); err != nil {
+		return false, err
+	}
+	conn.SetPongHandler(extendDeadline)
+
+	// ReadMessage doesn't take a context, so close the connection to unblock it
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
+				conn.Close()
 				return
-			default:
-				_, message, err := conn.ReadMessage()
-				if err != nil {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+					conn.Close()
 					return
 				}
+			}
+		}
+	}()
 
-				var log lclient.Log
-				err = json.Unmarshal(message, &log)
-				if err != nil {
-					return
-				}
+	var received bool
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return received, err
+		}
+		if err := extendDeadline(""); err != nil {
+			return received, err
+		}
 
-				ch <- &log
-			}
+		var log lclient.Log
+		if err := json.Unmarshal(message, &log); err != nil {
+			continue
 		}
-	}(ctx)
 
-	return ch, nil
+		if dedup.Seen(&log) {
+			continue
+		}
+		received = true
+
+		if !send(LogReceived{Log: &log}) {
+			return received, ctx.Err()
+		}
+	}
 }
@@ -0,0 +1,78 @@
+package logs_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
+)
+
+func TestListLogPages(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var limits []string
+	var startTimes []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		startTimes = append(startTimes, r.URL.Query().Get("startTime"))
+
+		page := len(limits)
+		var resp client.Logs200Response
+		resp.HasMore = page < 3
+		resp.NextStartTime = base.Add(time.Duration(page) * time.Hour)
+		resp.NextEndTime = base.Add(time.Duration(page+1) * time.Hour)
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		require.NoError(t, err)
+		for i := range limit {
+			resp.Logs = append(resp.Logs, lclient.Log{Id: fmt.Sprintf("%d-%d", page, i), Timestamp: base})
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer s.Close()
+
+	c, err := client.NewClientWithResponses(s.URL)
+	require.NoError(t, err)
+	repo := logs.NewLogRepo(c)
+
+	t.Run("stops at the limit", func(t *testing.T) {
+		limits, startTimes = nil, nil
+
+		var count int
+		err := repo.ListLogPages(context.Background(), &client.ListLogsParams{}, 150, func(page *client.Logs200Response) error {
+			count += len(page.Logs)
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 150, count)
+		require.Equal(t, []string{"100", "50"}, limits)
+		require.Empty(t, startTimes[0])
+		require.Equal(t, base.Add(time.Hour).Format(time.RFC3339), startTimes[1])
+	})
+
+	t.Run("fetches every page without a limit", func(t *testing.T) {
+		limits, startTimes = nil, nil
+
+		var count int
+		err := repo.ListLogPages(context.Background(), &client.ListLogsParams{}, 0, func(page *client.Logs200Response) error {
+			count += len(page.Logs)
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 300, count)
+		require.Equal(t, []string{"100", "100", "100"}, limits)
+	})
+}
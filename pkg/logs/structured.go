@@ -0,0 +1,261 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+)
+
+// ParseJSONMessage returns the fields of a log message that is a JSON object
+func ParseJSONMessage(message string) (map[string]any, bool) {
+	message = strings.TrimSpace(message)
+	if !strings.HasPrefix(message, "{") {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(message))
+	// keep numbers as written, so large IDs aren't rounded
+	decoder.UseNumber()
+
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, false
+	}
+	return fields, true
+}
+
+// lookupField returns a field of a JSON message, using dots for nested objects, e.g. http.status
+func lookupField(fields map[string]any, path string) (any, bool) {
+	var value any = fields
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func fieldString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return "null"
+	}
+
+	str, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(str)
+}
+
+var conditionRegex = regexp.MustCompile(`^\s*([\w.-]+)\s*(>=|<=|!=|=~|==|=|>|<)\s*(.*?)\s*$`)
+
+// Condition is a --where filter on a field of JSON log messages, or on a label like level or statusCode
+type Condition struct {
+	Field string
+	Op    string
+	Value string
+
+	re *regexp.Regexp
+}
+
+// ParseCondition parses a condition like status>=500, level=error or msg=~timeout. Values are compared as numbers
+// when both sides are numbers.
+func ParseCondition(str string) (Condition, error) {
+	match := conditionRegex.FindStringSubmatch(str)
+	if match == nil {
+		return Condition{}, fmt.Errorf("invalid condition %q: use a field, an operator (=, !=, >, >=, <, <=, =~) and a value, e.g. status>=500", str)
+	}
+
+	c := Condition{Field: match[1], Op: match[2], Value: strings.Trim(match[3], `"'`)}
+	if c.Op == "==" {
+		c.Op = "="
+	}
+	if c.Op == "=~" {
+		re, err := regexp.Compile(c.Value)
+		if err != nil {
+			return Condition{}, fmt.Errorf("invalid condition %q: %w", str, err)
+		}
+		c.re = re
+	}
+	return c, nil
+}
+
+// Matches checks the condition against the log's JSON fields, falling back to its labels. Logs without the field
+// don't match.
+func (c Condition) Matches(log *lclient.Log, fields map[string]any) bool {
+	var value string
+	if v, ok := lookupField(fields, c.Field); ok {
+		value = fieldString(v)
+	} else if v := LabelValue(log, lclient.LogLabelName(c.Field)); v != "" {
+		value = v
+	} else {
+		return false
+	}
+
+	if c.Op == "=~" {
+		return c.re.MatchString(value)
+	}
+
+	cmp := strings.Compare(value, c.Value)
+	if a, err := strconv.ParseFloat(value, 64); err == nil {
+		if b, err := strconv.ParseFloat(c.Value, 64); err == nil {
+			cmp = compareFloats(a, b)
+		}
+	}
+
+	switch c.Op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Pipeline processes logs between the API and where they're shown: it drops logs that don't match the conditions,
+// and collapses JSON messages to the selected fields or pretty prints them
+type Pipeline struct {
+	fields     []string
+	conditions []Condition
+	expandJSON bool
+}
+
+// NewPipeline returns nil if there is nothing to process, and nil pipelines pass logs through unchanged
+func NewPipeline(fields, where []string, expandJSON bool) (*Pipeline, error) {
+	if len(fields) == 0 && len(where) == 0 && !expandJSON {
+		return nil, nil
+	}
+
+	p := &Pipeline{fields: fields, expandJSON: expandJSON}
+	for _, str := range where {
+		c, err := ParseCondition(str)
+		if err != nil {
+			return nil, err
+		}
+		p.conditions = append(p.conditions, c)
+	}
+	return p, nil
+}
+
+// Process returns the log with its message rewritten, or false if the log should be dropped
+func (p *Pipeline) Process(log *lclient.Log) (*lclient.Log, bool) {
+	if p == nil {
+		return log, true
+	}
+
+	fields, isJSON := ParseJSONMessage(log.Message)
+	for _, c := range p.conditions {
+		if !c.Matches(log, fields) {
+			return nil, false
+		}
+	}
+
+	if !isJSON {
+		return log, true
+	}
+
+	processed := *log
+	if len(p.fields) > 0 {
+		processed.Message = collapseFields(fields, p.fields)
+	} else if p.expandJSON {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, []byte(strings.TrimSpace(log.Message)), "", "  "); err == nil {
+			processed.Message = buf.String()
+		}
+	}
+	return &processed, true
+}
+
+// collapseFields writes the selected fields as key=value pairs, quoting values with spaces
+func collapseFields(fields map[string]any, keys []string) string {
+	var pairs []string
+	for _, key := range keys {
+		value, ok := lookupField(fields, key)
+		if !ok {
+			continue
+		}
+
+		str := fieldString(value)
+		if strings.ContainsAny(str, " \t\n\"=") {
+			str = strconv.Quote(str)
+		}
+		pairs = append(pairs, key+"="+str)
+	}
+	return strings.Join(pairs, " ")
+}
+
+// Apply processes a page of logs
+func (p *Pipeline) Apply(logs []lclient.Log) []lclient.Log {
+	if p == nil {
+		return logs
+	}
+
+	var processed []lclient.Log
+	for _, log := range logs {
+		if l, ok := p.Process(&log); ok {
+			processed = append(processed, *l)
+		}
+	}
+	return processed
+}
+
+// Tail processes the logs of a tail, passing through the other events
+func (p *Pipeline) Tail(ctx context.Context, events <-chan TailEvent) <-chan TailEvent {
+	if p == nil {
+		return events
+	}
+
+	ch := make(chan TailEvent)
+	go func() {
+		defer close(ch)
+		for evt := range events {
+			if received, ok := evt.(LogReceived); ok {
+				log, keep := p.Process(received.Log)
+				if !keep {
+					continue
+				}
+				evt = LogReceived{Log: log}
+			}
+
+			select {
+			case ch <- evt:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,123 @@
+package logs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
+)
+
+func TestParseJSONMessage(t *testing.T) {
+	fields, ok := logs.ParseJSONMessage(` {"msg": "hi", "id": 12345678901234567890}`)
+	require.True(t, ok)
+	require.Equal(t, "hi", fields["msg"])
+
+	_, ok = logs.ParseJSONMessage("plain text")
+	require.False(t, ok)
+	_, ok = logs.ParseJSONMessage("{not json")
+	require.False(t, ok)
+}
+
+func TestParseCondition(t *testing.T) {
+	c, err := logs.ParseCondition("status >= 500")
+	require.NoError(t, err)
+	require.Equal(t, logs.Condition{Field: "status", Op: ">=", Value: "500"}, c)
+
+	c, err = logs.ParseCondition("user.name=='bob'")
+	require.NoError(t, err)
+	require.Equal(t, logs.Condition{Field: "user.name", Op: "=", Value: "bob"}, c)
+
+	_, err = logs.ParseCondition("status")
+	require.Error(t, err)
+	_, err = logs.ParseCondition("msg=~(")
+	require.Error(t, err)
+}
+
+func TestPipeline(t *testing.T) {
+	jsonLog := func(message string) *lclient.Log {
+		return &lclient.Log{
+			Message: message,
+			Labels:  []lclient.LogLabel{{Name: lclient.LogLabelNameLevel, Value: "error"}},
+		}
+	}
+
+	t.Run("nil pipeline passes logs through", func(t *testing.T) {
+		p, err := logs.NewPipeline(nil, nil, false)
+		require.NoError(t, err)
+		require.Nil(t, p)
+
+		log, ok := p.Process(jsonLog("hello"))
+		require.True(t, ok)
+		require.Equal(t, "hello", log.Message)
+	})
+
+	t.Run("filters on fields and labels", func(t *testing.T) {
+		p, err := logs.NewPipeline(nil, []string{"status>=500", "level=error"}, false)
+		require.NoError(t, err)
+
+		_, ok := p.Process(jsonLog(`{"status": 503}`))
+		require.True(t, ok)
+		_, ok = p.Process(jsonLog(`{"status": 404}`))
+		require.False(t, ok)
+		// numbers are compared as numbers, not strings
+		_, ok = p.Process(jsonLog(`{"status": "1000"}`))
+		require.True(t, ok)
+		_, ok = p.Process(jsonLog("not json"))
+		require.False(t, ok)
+	})
+
+	t.Run("matches regexes", func(t *testing.T) {
+		p, err := logs.NewPipeline(nil, []string{"msg=~time(out|d out)"}, false)
+		require.NoError(t, err)
+
+		_, ok := p.Process(jsonLog(`{"msg": "request timed out"}`))
+		require.True(t, ok)
+		_, ok = p.Process(jsonLog(`{"msg": "ok"}`))
+		require.False(t, ok)
+	})
+
+	t.Run("collapses to fields", func(t *testing.T) {
+		p, err := logs.NewPipeline([]string{"msg", "user_id", "http.status", "missing"}, nil, false)
+		require.NoError(t, err)
+
+		log, ok := p.Process(jsonLog(`{"msg": "user signed in", "user_id": 42, "http": {"status": 200}, "other": true}`))
+		require.True(t, ok)
+		require.Equal(t, `msg="user signed in" user_id=42 http.status=200`, log.Message)
+
+		log, ok = p.Process(jsonLog("plain text"))
+		require.True(t, ok)
+		require.Equal(t, "plain text", log.Message)
+	})
+
+	t.Run("expands JSON", func(t *testing.T) {
+		p, err := logs.NewPipeline(nil, nil, true)
+		require.NoError(t, err)
+
+		log, ok := p.Process(jsonLog(`{"msg":"hi"}`))
+		require.True(t, ok)
+		require.Equal(t, "{\n  \"msg\": \"hi\"\n}", log.Message)
+	})
+
+	t.Run("tails", func(t *testing.T) {
+		p, err := logs.NewPipeline(nil, []string{"status>=500"}, false)
+		require.NoError(t, err)
+
+		events := make(chan logs.TailEvent, 3)
+		events <- logs.LogReceived{Log: jsonLog(`{"status": 200}`)}
+		events <- logs.Reconnected{Backfilled: 1}
+		events <- logs.LogReceived{Log: jsonLog(`{"status": 500}`)}
+		close(events)
+
+		var processed []logs.TailEvent
+		for evt := range p.Tail(context.Background(), events) {
+			processed = append(processed, evt)
+		}
+		require.Equal(t, []logs.TailEvent{
+			logs.Reconnected{Backfilled: 1},
+			logs.LogReceived{Log: jsonLog(`{"status": 500}`)},
+		}, processed)
+	})
+}
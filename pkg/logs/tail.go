@@ -0,0 +1,96 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/renderinc/cli/pkg/backoff"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+)
+
+// reconnectBackoff spaces out reconnects of a dropped tail
+var reconnectBackoff = backoff.Exponential{Min: time.Second, Max: 30 * time.Second}
+
+const (
+	// resumeOverlap is how far before the latest log a reconnect resumes from, since logs from different
+	// instances can arrive slightly out of order
+	resumeOverlap = 5 * time.Second
+
+	// pingInterval is how often the server is pinged to keep idle connections open, and pongWait is how long to
+	// wait for any message before treating the connection as dropped
+	pingInterval = 30 * time.Second
+	pongWait     = 60 * time.Second
+	writeWait    = 10 * time.Second
+
+	// dedupSize is the number of recent log IDs remembered to drop logs replayed after a reconnect
+	dedupSize = 10000
+)
+
+// Dedup drops logs that were already sent and tracks where to resume the stream from
+type Dedup struct {
+	last  time.Time
+	ids   map[string]struct{}
+	order []string
+}
+
+func (d *Dedup) Seen(log *lclient.Log) bool {
+	if _, ok := d.ids[log.Id]; ok {
+		return true
+	}
+
+	if d.ids == nil {
+		d.ids = map[string]struct{}{}
+	}
+	if len(d.order) == dedupSize {
+		delete(d.ids, d.order[0])
+		d.order = d.order[1:]
+	}
+	d.ids[log.Id] = struct{}{}
+	d.order = append(d.order, log.Id)
+
+	if log.Timestamp.After(d.last) {
+		d.last = log.Timestamp
+	}
+	return false
+}
+
+// ResumeFrom returns the start time to reconnect with, or nil if no log was received yet
+func (d *Dedup) ResumeFrom() *time.Time {
+	if d.last.IsZero() {
+		return nil
+	}
+	start := d.last.Add(-resumeOverlap)
+	return &start
+}
+
+// TailEvent is sent on the channel returned by TailLogs. It is one of LogReceived, Disconnected, Reconnected or
+// TailFailed.
+type TailEvent interface {
+	tailEvent()
+}
+
+// LogReceived is sent for each new log
+type LogReceived struct {
+	Log *lclient.Log
+}
+
+// Disconnected is sent when the connection drops, before waiting RetryIn to reconnect
+type Disconnected struct {
+	Err     error
+	RetryIn time.Duration
+}
+
+// Reconnected is sent once the stream resumes. Backfilled is the number of logs missed while disconnected that were
+// sent before it.
+type Reconnected struct {
+	Backfilled int
+}
+
+// TailFailed is the last event sent when the API rejects a reconnect
+type TailFailed struct {
+	Err error
+}
+
+func (LogReceived) tailEvent()  {}
+func (Disconnected) tailEvent() {}
+func (Reconnected) tailEvent()  {}
+func (TailFailed) tailEvent()   {}
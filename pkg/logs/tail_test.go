@@ -0,0 +1,51 @@
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
+)
+
+func TestDedup(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	log := func(id string, offset time.Duration) *lclient.Log {
+		return &lclient.Log{Id: id, Timestamp: base.Add(offset)}
+	}
+
+	t.Run("drops replayed logs", func(t *testing.T) {
+		var d logs.Dedup
+		require.Nil(t, d.ResumeFrom())
+
+		require.False(t, d.Seen(log("a", 0)))
+		require.False(t, d.Seen(log("b", time.Second)))
+		// out of order logs from another instance aren't dropped
+		require.False(t, d.Seen(log("c", 0)))
+
+		require.True(t, d.Seen(log("a", 0)))
+		require.True(t, d.Seen(log("b", time.Second)))
+		require.False(t, d.Seen(log("d", 2*time.Second)))
+	})
+
+	t.Run("resumes shortly before the latest log", func(t *testing.T) {
+		var d logs.Dedup
+		d.Seen(log("a", 10*time.Second))
+		d.Seen(log("b", 5*time.Second))
+
+		require.Equal(t, base.Add(5*time.Second), *d.ResumeFrom())
+	})
+
+	t.Run("forgets old IDs", func(t *testing.T) {
+		var d logs.Dedup
+		for i := range 10001 {
+			d.Seen(log(fmt.Sprint(i), 0))
+		}
+
+		require.False(t, d.Seen(log("0", 0)))
+		require.True(t, d.Seen(log("10000", 0)))
+	})
+}
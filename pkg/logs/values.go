@@ -0,0 +1,124 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/renderinc/cli/pkg/client"
+	mclient "github.com/renderinc/cli/pkg/client/metrics"
+)
+
+// SuggestedFilters are the logs flags that values are suggested for
+var SuggestedFilters = []string{"instance", "host", "status-code", "path"}
+
+var filterLabels = map[string]client.ListLogsValuesParamsLabel{
+	"instance":    client.Instance,
+	"host":        client.Host,
+	"status-code": client.StatusCode,
+}
+
+// FilterValues returns the values seen for the logs filter in params' resources and time range, e.g. the instance
+// IDs of a service. Hosts and status codes seen in HTTP metrics are included too, and paths only come from them since
+// paths aren't a log label.
+func (l *LogRepo) FilterValues(ctx context.Context, params *client.ListLogsParams, filter string) ([]string, error) {
+	var values []string
+	var errs []error
+
+	if label, ok := filterLabels[filter]; ok {
+		logValues, err := l.listLogsValues(ctx, params, label)
+		values = append(values, logValues...)
+		errs = append(errs, err)
+	}
+
+	if filter == "host" || filter == "status-code" || filter == "path" {
+		for _, resourceID := range params.Resource {
+			metricValues, err := l.listHTTPFilterValues(ctx, params, resourceID, filter)
+			values = append(values, metricValues...)
+			errs = append(errs, err)
+		}
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	// one source failing is fine as long as another returned values
+	if len(values) == 0 {
+		return nil, errors.Join(errs...)
+	}
+	return values, nil
+}
+
+func (l *LogRepo) listLogsValues(ctx context.Context, params *client.ListLogsParams, label client.ListLogsValuesParamsLabel) ([]string, error) {
+	resp, err := l.c.ListLogsValuesWithResponse(ctx, &client.ListLogsValuesParams{
+		OwnerId:   params.OwnerId,
+		Label:     label,
+		Resource:  params.Resource,
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+	return *resp.JSON200, nil
+}
+
+func (l *LogRepo) listHTTPFilterValues(ctx context.Context, params *client.ListLogsParams, resourceID, filter string) ([]string, error) {
+	if filter == "path" {
+		resp, err := l.c.ListPathFilterValuesWithResponse(ctx, &client.ListPathFilterValuesParams{
+			Resource:  &resourceID,
+			StartTime: params.StartTime,
+			EndTime:   params.EndTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.ErrorFromResponse(resp); err != nil {
+			return nil, err
+		}
+
+		if resp.JSON200 == nil {
+			return nil, nil
+		}
+		return *resp.JSON200, nil
+	}
+
+	resp, err := l.c.ListHttpFilterValuesWithResponse(ctx, &client.ListHttpFilterValuesParams{
+		Resource:  &resourceID,
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	want := mclient.FilterHTTPValuesCollectionFilterHost
+	if filter == "status-code" {
+		want = mclient.FilterHTTPValuesCollectionFilterStatusCode
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	var values []string
+	for _, f := range *resp.JSON200 {
+		if f.Filter != nil && *f.Filter == want && f.Values != nil {
+			values = append(values, *f.Values...)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,89 @@
+package logstream
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const dialTimeout = 10 * time.Second
+
+// ParseEndpoint returns the host and port of a log stream endpoint like logs.example.com:6514. Schemes such as
+// tls:// are ignored, Render always streams logs over TLS.
+func ParseEndpoint(endpoint string) (string, string, error) {
+	address := strings.TrimSpace(endpoint)
+	if _, rest, ok := strings.Cut(address, "://"); ok {
+		address = rest
+	}
+	address = strings.TrimSuffix(address, "/")
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint %q: use host:port, e.g. logs.example.com:6514", endpoint)
+	}
+	if host == "" || port == "" {
+		return "", "", fmt.Errorf("invalid endpoint %q: use host:port, e.g. logs.example.com:6514", endpoint)
+	}
+	return host, port, nil
+}
+
+// TestResult is a successful connection to a log stream endpoint
+type TestResult struct {
+	Endpoint   string        `json:"endpoint"`
+	Address    string        `json:"address"`
+	TLSVersion string        `json:"tlsVersion"`
+	Issuer     string        `json:"issuer,omitempty"`
+	NotAfter   time.Time     `json:"notAfter,omitempty"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// TestEndpoint connects to the endpoint over TCP and completes a TLS handshake, the same way Render connects when
+// streaming logs. Nothing is sent. A nil tlsConfig verifies the certificate against the system roots.
+func TestEndpoint(ctx context.Context, endpoint string, tlsConfig *tls.Config) (*TestResult, error) {
+	host, port, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	address := net.JoinHostPort(host, port)
+
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	start := time.Now()
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, fmt.Errorf("connected to %s, but the TLS handshake failed: %w", address, err)
+	}
+
+	state := tlsConn.ConnectionState()
+	result := &TestResult{
+		Endpoint:   endpoint,
+		Address:    conn.RemoteAddr().String(),
+		TLSVersion: tls.VersionName(state.Version),
+		Duration:   time.Since(start),
+	}
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		result.Issuer = cert.Issuer.CommonName
+		result.NotAfter = cert.NotAfter
+	}
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package logstream_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/logstream"
+)
+
+func TestTestEndpoint(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	endpoint := server.Listener.Addr().String()
+	tlsConfig := server.Client().Transport.(*http.Transport).TLSClientConfig
+
+	t.Run("completes the TLS handshake", func(t *testing.T) {
+		result, err := logstream.TestEndpoint(context.Background(), endpoint, tlsConfig)
+		require.NoError(t, err)
+		require.Equal(t, endpoint, result.Endpoint)
+		require.NotEmpty(t, result.TLSVersion)
+		require.False(t, result.NotAfter.IsZero())
+	})
+
+	t.Run("fails for untrusted certificates", func(t *testing.T) {
+		_, err := logstream.TestEndpoint(context.Background(), endpoint, &tls.Config{})
+		require.ErrorContains(t, err, "TLS handshake failed")
+	})
+
+	t.Run("fails when nothing is listening", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		closedEndpoint := listener.Addr().String()
+		require.NoError(t, listener.Close())
+
+		_, err = logstream.TestEndpoint(context.Background(), closedEndpoint, tlsConfig)
+		require.ErrorContains(t, err, "could not connect")
+	})
+}
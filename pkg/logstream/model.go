@@ -0,0 +1,90 @@
+package logstream
+
+import (
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/resource"
+)
+
+// SettingValues are whether a resource's logs are sent to a log stream or dropped
+var SettingValues = []string{
+	string(lclient.LogStreamSettingSend),
+	string(lclient.LogStreamSettingDrop),
+}
+
+// PreviewValues are whether preview resources send their logs to the workspace log stream
+var PreviewValues = []string{
+	string(lclient.LogStreamPreviewSettingSend),
+	string(lclient.LogStreamPreviewSettingDrop),
+}
+
+// ResourceLogStream is where the logs of a single resource go after applying its override, if any, to the
+// workspace log stream
+type ResourceLogStream struct {
+	ResourceID   string                            `json:"resourceId"`
+	ResourceName string                            `json:"resourceName"`
+	ResourceType string                            `json:"resourceType"`
+	Project      string                            `json:"project,omitempty"`
+	Environment  string                            `json:"environment,omitempty"`
+	IsPreview    bool                              `json:"isPreview"`
+	Setting      lclient.LogStreamSetting          `json:"setting"`
+	Endpoint     string                            `json:"endpoint,omitempty"`
+	Override     *lclient.ResourceLogStreamSetting `json:"override,omitempty"`
+}
+
+// IsOverride returns true if the resource doesn't use the workspace log stream
+func (s *ResourceLogStream) IsOverride() bool {
+	return s.Override != nil && s.Override.Setting != nil
+}
+
+// Effective applies a resource override to the workspace log stream. Logs are dropped when neither has an endpoint,
+// and preview resources drop their logs when the workspace drops previews.
+func Effective(settings *lclient.OwnerLogStreamSetting, override *lclient.ResourceLogStreamSetting, isPreview bool) (lclient.LogStreamSetting, string) {
+	if override != nil && override.Setting != nil {
+		if *override.Setting == lclient.LogStreamSettingDrop || override.Endpoint == nil {
+			return lclient.LogStreamSettingDrop, ""
+		}
+		return lclient.LogStreamSettingSend, *override.Endpoint
+	}
+
+	if settings == nil || settings.Endpoint == nil || *settings.Endpoint == "" {
+		return lclient.LogStreamSettingDrop, ""
+	}
+	if isPreview && settings.Preview != nil && *settings.Preview == lclient.LogStreamPreviewSettingDrop {
+		return lclient.LogStreamSettingDrop, ""
+	}
+	return lclient.LogStreamSettingSend, *settings.Endpoint
+}
+
+// ForResources returns where the logs of every resource go, in the order the resources are given
+func ForResources(settings *lclient.OwnerLogStreamSetting, overrides []*lclient.ResourceLogStreamSetting, resources []resource.Resource) []*ResourceLogStream {
+	byResourceID := make(map[string]*lclient.ResourceLogStreamSetting, len(overrides))
+	for _, o := range overrides {
+		if o.ResourceId != nil {
+			byResourceID[*o.ResourceId] = o
+		}
+	}
+
+	result := make([]*ResourceLogStream, 0, len(resources))
+	for _, r := range resources {
+		isPreview := false
+		// only services have previews
+		if p, ok := r.(interface{ IsPreview() bool }); ok {
+			isPreview = p.IsPreview()
+		}
+
+		override := byResourceID[r.ID()]
+		setting, endpoint := Effective(settings, override, isPreview)
+		result = append(result, &ResourceLogStream{
+			ResourceID:   r.ID(),
+			ResourceName: r.Name(),
+			ResourceType: r.Type(),
+			Project:      r.ProjectName(),
+			Environment:  r.EnvironmentName(),
+			IsPreview:    isPreview,
+			Setting:      setting,
+			Endpoint:     endpoint,
+			Override:     override,
+		})
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package logstream_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func TestEffective(t *testing.T) {
+	settings := &lclient.OwnerLogStreamSetting{
+		Endpoint: pointers.From("logs.example.com:6514"),
+		Preview:  pointers.From(lclient.LogStreamPreviewSettingDrop),
+	}
+
+	tcs := []struct {
+		name             string
+		settings         *lclient.OwnerLogStreamSetting
+		override         *lclient.ResourceLogStreamSetting
+		isPreview        bool
+		expectedSetting  lclient.LogStreamSetting
+		expectedEndpoint string
+	}{
+		{
+			name:             "no override uses workspace log stream",
+			settings:         settings,
+			expectedSetting:  lclient.LogStreamSettingSend,
+			expectedEndpoint: "logs.example.com:6514",
+		},
+		{
+			name:            "no workspace log stream drops logs",
+			expectedSetting: lclient.LogStreamSettingDrop,
+		},
+		{
+			name:            "previews drop logs when the workspace drops previews",
+			settings:        settings,
+			isPreview:       true,
+			expectedSetting: lclient.LogStreamSettingDrop,
+		},
+		{
+			name:     "drop override",
+			settings: settings,
+			override: &lclient.ResourceLogStreamSetting{
+				Setting: pointers.From(lclient.LogStreamSettingDrop),
+			},
+			expectedSetting: lclient.LogStreamSettingDrop,
+		},
+		{
+			name: "endpoint override",
+			override: &lclient.ResourceLogStreamSetting{
+				Setting:  pointers.From(lclient.LogStreamSettingSend),
+				Endpoint: pointers.From("siem.example.com:514"),
+			},
+			isPreview:        true,
+			expectedSetting:  lclient.LogStreamSettingSend,
+			expectedEndpoint: "siem.example.com:514",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			setting, endpoint := logstream.Effective(tc.settings, tc.override, tc.isPreview)
+			require.Equal(t, tc.expectedSetting, setting)
+			require.Equal(t, tc.expectedEndpoint, endpoint)
+		})
+	}
+}
+
+func TestParseEndpoint(t *testing.T) {
+	host, port, err := logstream.ParseEndpoint("logs.example.com:6514")
+	require.NoError(t, err)
+	require.Equal(t, "logs.example.com", host)
+	require.Equal(t, "6514", port)
+
+	host, port, err = logstream.ParseEndpoint("tls://logs.example.com:6514/")
+	require.NoError(t, err)
+	require.Equal(t, "logs.example.com", host)
+	require.Equal(t, "6514", port)
+
+	_, _, err = logstream.ParseEndpoint("logs.example.com")
+	require.Error(t, err)
+
+	_, _, err = logstream.ParseEndpoint(":6514")
+	require.Error(t, err)
+}
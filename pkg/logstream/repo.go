@@ -0,0 +1,167 @@
+package logstream
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/renderinc/cli/pkg/client"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+// maxOverrides is the most overrides the API returns at once. Its responses don't include a cursor, so there is
+// no next page to ask for. Each resource has at most one override, so asking for this many resources at a time
+// returns all of their overrides.
+const maxOverrides = 100
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// GetSettings returns the log stream of the active workspace, or nil if none is configured
+func (r *Repo) GetSettings(ctx context.Context) (*lclient.OwnerLogStreamSetting, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.GetOwnerLogStreamWithResponse(ctx, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UpdateSettings(ctx context.Context, update lclient.LogStreamOwnerUpdate) (*lclient.OwnerLogStreamSetting, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.UpdateOwnerLogStreamWithResponse(ctx, workspace, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteSettings(ctx context.Context) error {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.client.DeleteOwnerLogStreamWithResponse(ctx, workspace)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// ListOverrides returns the overrides of the given resources. Resources that use the workspace log stream are left
+// out. The resources are fetched in batches of maxOverrides, so every batch fits in a single page.
+func (r *Repo) ListOverrides(ctx context.Context, resourceIDs []string) ([]*lclient.ResourceLogStreamSetting, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	var overrides []*lclient.ResourceLogStreamSetting
+	for start := 0; start < len(resourceIDs); start += maxOverrides {
+		end := min(start+maxOverrides, len(resourceIDs))
+
+		params := &client.ListResourceLogStreamsParams{
+			ResourceId: pointers.From(resourceIDs[start:end]),
+			Limit:      pointers.From(maxOverrides),
+		}
+		if workspace != "" {
+			params.OwnerId = pointers.From([]string{workspace})
+		}
+
+		batch, err := r.listOverrides(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, batch...)
+	}
+	return overrides, nil
+}
+
+func (r *Repo) listOverrides(ctx context.Context, params *client.ListResourceLogStreamsParams) ([]*lclient.ResourceLogStreamSetting, error) {
+	resp, err := r.client.ListResourceLogStreamsWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	overrides := make([]*lclient.ResourceLogStreamSetting, 0, len(*resp.JSON200))
+	for _, o := range *resp.JSON200 {
+		overrides = append(overrides, &o)
+	}
+	return overrides, nil
+}
+
+// GetOverride returns the resource's override, or nil if it uses the workspace log stream
+func (r *Repo) GetOverride(ctx context.Context, resourceID string) (*lclient.ResourceLogStreamSetting, error) {
+	resp, err := r.client.GetResourceLogStreamWithResponse(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UpdateOverride(ctx context.Context, resourceID string, update lclient.LogStreamResourceUpdate) (*lclient.ResourceLogStreamSetting, error) {
+	resp, err := r.client.UpdateResourceLogStreamWithResponse(ctx, resourceID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// DeleteOverride makes the resource use the workspace log stream again
+func (r *Repo) DeleteOverride(ctx context.Context, resourceID string) error {
+	resp, err := r.client.DeleteResourceLogStreamWithResponse(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
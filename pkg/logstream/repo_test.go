@@ -0,0 +1,54 @@
+package logstream_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func TestListOverrides(t *testing.T) {
+	t.Setenv("RENDER_WORKSPACE", "tea-1")
+
+	var batches []int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "tea-1", r.URL.Query().Get("ownerId"))
+
+		resourceIDs := r.URL.Query()["resourceId"]
+		batches = append(batches, len(resourceIDs))
+
+		var resp []lclient.ResourceLogStreamSetting
+		for _, id := range resourceIDs {
+			resp = append(resp, lclient.ResourceLogStreamSetting{ResourceId: pointers.From(id)})
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer s.Close()
+
+	c, err := client.NewClientWithResponses(s.URL)
+	require.NoError(t, err)
+	repo := logstream.NewRepo(c)
+
+	var resourceIDs []string
+	for i := range 250 {
+		resourceIDs = append(resourceIDs, fmt.Sprintf("srv-%d", i))
+	}
+
+	overrides, err := repo.ListOverrides(context.Background(), resourceIDs)
+	require.NoError(t, err)
+
+	require.Len(t, overrides, 250)
+	require.Equal(t, "srv-249", *overrides[249].ResourceId)
+	require.Equal(t, []int{100, 100, 50}, batches)
+}
@@ -0,0 +1,82 @@
+package logstream
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+)
+
+// Details describes the workspace log stream. A nil setting means none is configured.
+func Details(s *lclient.OwnerLogStreamSetting) string {
+	if s == nil || s.Endpoint == nil || *s.Endpoint == "" {
+		return "No log stream is configured for this workspace"
+	}
+
+	preview := string(lclient.LogStreamPreviewSettingSend)
+	if s.Preview != nil {
+		preview = string(*s.Preview)
+	}
+
+	lines := []string{
+		fmt.Sprintf("%-10s %s", "Endpoint:", *s.Endpoint),
+		fmt.Sprintf("%-10s %s", "Previews:", preview),
+	}
+	return strings.Join(lines, "\n")
+}
+
+func Header() []string {
+	return []string{"Resource", "Type", "Setting", "Endpoint", "ID"}
+}
+
+func Row(s *ResourceLogStream) []string {
+	return []string{
+		ResourceLabel(s),
+		s.ResourceType,
+		SettingLabel(s),
+		s.Endpoint,
+		s.ResourceID,
+	}
+}
+
+// ResourceLabel returns the resource name with its project and environment
+func ResourceLabel(s *ResourceLogStream) string {
+	if s.Project != "" && s.Environment != "" {
+		return s.ResourceName + " (" + s.Project + " - " + s.Environment + ")"
+	}
+	return s.ResourceName
+}
+
+// SettingLabel returns the effective setting, marking settings that come from a resource override
+func SettingLabel(s *ResourceLogStream) string {
+	if s.IsOverride() {
+		return string(s.Setting) + " (override)"
+	}
+	return string(s.Setting)
+}
+
+// OverrideMessage describes a resource's override. A nil override means the resource uses the workspace log stream.
+func OverrideMessage(resourceID string, o *lclient.ResourceLogStreamSetting) string {
+	if o == nil || o.Setting == nil {
+		return fmt.Sprintf("%s uses the workspace log stream", resourceID)
+	}
+	if *o.Setting == lclient.LogStreamSettingDrop || o.Endpoint == nil {
+		return fmt.Sprintf("%s drops its logs instead of using the workspace log stream", resourceID)
+	}
+	return fmt.Sprintf("%s sends its logs to %s instead of the workspace log stream", resourceID, *o.Endpoint)
+}
+
+func TestDetails(r *TestResult) string {
+	lines := []string{
+		fmt.Sprintf("Reached %s (%s) in %s", r.Endpoint, r.Address, r.Duration.Round(time.Millisecond)),
+		fmt.Sprintf("%-8s %s", "TLS:", r.TLSVersion),
+	}
+	if r.Issuer != "" {
+		lines = append(lines, fmt.Sprintf("%-8s %s", "Issuer:", r.Issuer))
+	}
+	if !r.NotAfter.IsZero() {
+		lines = append(lines, fmt.Sprintf("%-8s %s", "Expires:", r.NotAfter.Format(time.DateOnly)))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,97 @@
+package maintenance
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	clientmaintenance "github.com/renderinc/cli/pkg/client/maintenance"
+	"github.com/renderinc/cli/pkg/resource"
+)
+
+var States = []string{
+	string(clientmaintenance.Scheduled),
+	string(clientmaintenance.InProgress),
+	string(clientmaintenance.Succeeded),
+	string(clientmaintenance.Failed),
+	string(clientmaintenance.Cancelled),
+	string(clientmaintenance.UserFixRequired),
+}
+
+// Model is a maintenance run with the name of the resource it affects
+type Model struct {
+	Run          *clientmaintenance.MaintenanceRunWithResource `json:"maintenance"`
+	ResourceName string                                        `json:"resourceName,omitempty"`
+	ResourceType string                                        `json:"resourceType,omitempty"`
+	Project      string                                        `json:"project,omitempty"`
+	Environment  string                                        `json:"environment,omitempty"`
+}
+
+// WithResources matches each run to its resource. Runs for resources that aren't in the list, for example ones in
+// another workspace, keep only the resource ID. Runs are sorted by when they are scheduled.
+func WithResources(runs []*clientmaintenance.MaintenanceRunWithResource, resources []resource.Resource) []*Model {
+	byID := make(map[string]resource.Resource, len(resources))
+	for _, r := range resources {
+		byID[r.ID()] = r
+	}
+
+	models := make([]*Model, 0, len(runs))
+	for _, run := range runs {
+		m := &Model{Run: run}
+		if r, ok := byID[run.ResourceId]; ok {
+			m.ResourceName = r.Name()
+			m.ResourceType = r.Type()
+			m.Project = r.ProjectName()
+			m.Environment = r.EnvironmentName()
+		}
+		models = append(models, m)
+	}
+
+	slices.SortStableFunc(models, func(a, b *Model) int {
+		return a.Run.ScheduledAt.Compare(b.Run.ScheduledAt)
+	})
+	return models
+}
+
+var relativeRegex = regexp.MustCompile(`^\+?(\d+)([mhd])$`)
+
+var unitToDuration = map[string]time.Duration{
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": time.Hour * 24,
+}
+
+// ParseScheduledAt parses a time in RFC3339 format or relative to now, like 2h or +3d
+func ParseScheduledAt(now time.Time, s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if matches := relativeRegex.FindStringSubmatch(s); matches != nil {
+		n, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(unitToDuration[matches[2]] * time.Duration(n)), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, use RFC3339 like 2024-06-01T02:00:00Z or a duration from now like 2h or 3d", s)
+	}
+	return t, nil
+}
+
+// ValidateScheduledAt checks a new time against the window the run must happen in
+func ValidateScheduledAt(now time.Time, run *clientmaintenance.MaintenanceRunWithResource, t time.Time) error {
+	if run.State != clientmaintenance.Scheduled {
+		return fmt.Errorf("maintenance %s is %s, only scheduled maintenance can be changed", run.Id, run.State)
+	}
+	if t.Before(now) {
+		return fmt.Errorf("%s is in the past", t.Format(time.RFC3339))
+	}
+	if run.PendingMaintenanceBy != nil && t.After(*run.PendingMaintenanceBy) {
+		return fmt.Errorf("maintenance %s must happen before %s", run.Id, run.PendingMaintenanceBy.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clientmaintenance "github.com/renderinc/cli/pkg/client/maintenance"
+	"github.com/renderinc/cli/pkg/maintenance"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func TestParseScheduledAt(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tcs := []struct {
+		name     string
+		str      string
+		expected time.Time
+		err      bool
+	}{
+		{name: "minutes", str: "30m", expected: now.Add(30 * time.Minute)},
+		{name: "hours with plus", str: "+2h", expected: now.Add(2 * time.Hour)},
+		{name: "days", str: "3d", expected: now.Add(72 * time.Hour)},
+		{name: "rfc3339", str: "2024-06-02T02:00:00Z", expected: time.Date(2024, 6, 2, 2, 0, 0, 0, time.UTC)},
+		{name: "invalid", str: "tomorrow", err: true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := maintenance.ParseScheduledAt(now, tc.str)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.expected.Equal(got), "expected %s, got %s", tc.expected, got)
+		})
+	}
+}
+
+func TestValidateScheduledAt(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	run := &clientmaintenance.MaintenanceRunWithResource{
+		Id:                   "mrn-1",
+		State:                clientmaintenance.Scheduled,
+		ScheduledAt:          now.Add(time.Hour),
+		PendingMaintenanceBy: pointers.From(now.Add(48 * time.Hour)),
+	}
+
+	require.NoError(t, maintenance.ValidateScheduledAt(now, run, now.Add(24*time.Hour)))
+	require.ErrorContains(t, maintenance.ValidateScheduledAt(now, run, now.Add(-time.Hour)), "in the past")
+	require.ErrorContains(t, maintenance.ValidateScheduledAt(now, run, now.Add(72*time.Hour)), "must happen before")
+
+	done := *run
+	done.State = clientmaintenance.Succeeded
+	require.ErrorContains(t, maintenance.ValidateScheduledAt(now, &done, now.Add(time.Hour)), "only scheduled")
+}
+
+func TestWithResourcesSortsByScheduledAt(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	runs := []*clientmaintenance.MaintenanceRunWithResource{
+		{Id: "mrn-late", ResourceId: "srv-1", ScheduledAt: now.Add(2 * time.Hour)},
+		{Id: "mrn-early", ResourceId: "srv-2", ScheduledAt: now.Add(time.Hour)},
+	}
+
+	models := maintenance.WithResources(runs, nil)
+	require.Len(t, models, 2)
+	require.Equal(t, "mrn-early", models[0].Run.Id)
+	require.Equal(t, "mrn-late", models[1].Run.Id)
+	require.Empty(t, models[0].ResourceName)
+}
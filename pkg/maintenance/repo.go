@@ -0,0 +1,79 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientmaintenance "github.com/renderinc/cli/pkg/client/maintenance"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+func (r *Repo) ListMaintenance(ctx context.Context, params *client.ListMaintenanceParams) ([]*clientmaintenance.MaintenanceRunWithResource, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+
+	resp, err := r.client.ListMaintenanceWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	runs := make([]*clientmaintenance.MaintenanceRunWithResource, 0, len(*resp.JSON200))
+	for i := range *resp.JSON200 {
+		runs = append(runs, &(*resp.JSON200)[i])
+	}
+	return runs, nil
+}
+
+func (r *Repo) GetMaintenance(ctx context.Context, id string) (*clientmaintenance.MaintenanceRunWithResource, error) {
+	resp, err := r.client.RetrieveMaintenanceWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) RescheduleMaintenance(ctx context.Context, id string, scheduledAt time.Time) error {
+	resp, err := r.client.UpdateMaintenanceWithResponse(ctx, id, client.UpdateMaintenanceJSONRequestBody{ScheduledAt: &scheduledAt})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// TriggerMaintenance starts a scheduled maintenance run immediately
+func (r *Repo) TriggerMaintenance(ctx context.Context, id string) error {
+	resp, err := r.client.TriggerMaintenanceWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
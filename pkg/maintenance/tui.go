@@ -0,0 +1,34 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+func Header() []string {
+	return []string{"Resource", "Type", "Maintenance", "State", "Scheduled", "Deadline", "ID"}
+}
+
+func Row(m *Model) []string {
+	return []string{
+		ResourceLabel(m),
+		m.ResourceType,
+		m.Run.Type,
+		string(m.Run.State),
+		m.Run.ScheduledAt.Format(time.RFC3339),
+		pointers.TimeValue(m.Run.PendingMaintenanceBy),
+		m.Run.Id,
+	}
+}
+
+// ResourceLabel returns the resource name with its project and environment, or its ID if it wasn't found
+func ResourceLabel(m *Model) string {
+	if m.ResourceName == "" {
+		return m.Run.ResourceId
+	}
+	if m.Project != "" && m.Environment != "" {
+		return m.ResourceName + " (" + m.Project + " - " + m.Environment + ")"
+	}
+	return m.ResourceName
+}
@@ -0,0 +1,133 @@
+package notification
+
+import (
+	"fmt"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/service"
+)
+
+const (
+	On  = "on"
+	Off = "off"
+)
+
+// NotifyValues are the workspace settings for which service events send a notification
+var NotifyValues = []string{
+	string(clientnotifications.All),
+	string(clientnotifications.Failure),
+	string(clientnotifications.None),
+}
+
+// NotifyOverrideValues are NotifyValues plus default, which makes a service use the workspace setting
+var NotifyOverrideValues = []string{
+	string(clientnotifications.NotifyOverrideDefault),
+	string(clientnotifications.NotifyOverrideAll),
+	string(clientnotifications.NotifyOverrideFailure),
+	string(clientnotifications.NotifyOverrideNone),
+}
+
+var OnOffValues = []string{On, Off}
+
+var PreviewOverrideValues = []string{string(clientnotifications.NotifyPreviewOverrideDefault), On, Off}
+
+// ParseOnOff parses an on or off flag value. Empty strings mean the flag wasn't set and return nil.
+func ParseOnOff(s string) (*bool, error) {
+	switch s {
+	case "":
+		return nil, nil
+	case On:
+		enabled := true
+		return &enabled, nil
+	case Off:
+		enabled := false
+		return &enabled, nil
+	}
+	return nil, fmt.Errorf("must be %s or %s, got %q", On, Off, s)
+}
+
+// ToPreviewOverride converts a default, on or off flag value to the API value
+func ToPreviewOverride(s string) (clientnotifications.NotifyPreviewOverride, error) {
+	switch s {
+	case string(clientnotifications.NotifyPreviewOverrideDefault):
+		return clientnotifications.NotifyPreviewOverrideDefault, nil
+	case On:
+		return clientnotifications.NotifyPreviewOverrideTrue, nil
+	case Off:
+		return clientnotifications.NotifyPreviewOverrideFalse, nil
+	}
+	return "", fmt.Errorf("must be default, %s or %s, got %q", On, Off, s)
+}
+
+// ServiceNotifications is the notification behavior of a single service after applying its override, if any,
+// to the workspace settings
+type ServiceNotifications struct {
+	ServiceID                   string                                    `json:"serviceId"`
+	ServiceName                 string                                    `json:"serviceName"`
+	ServiceType                 string                                    `json:"serviceType"`
+	Project                     string                                    `json:"project,omitempty"`
+	Environment                 string                                    `json:"environment,omitempty"`
+	IsPreview                   bool                                      `json:"isPreview"`
+	NotificationsToSend         clientnotifications.NotifySettingV2       `json:"notificationsToSend"`
+	PreviewNotificationsEnabled bool                                      `json:"previewNotificationsEnabled"`
+	Override                    *clientnotifications.NotificationOverride `json:"override,omitempty"`
+}
+
+// OverridesNotify returns true if the service doesn't use the workspace setting for which events to notify on
+func (s *ServiceNotifications) OverridesNotify() bool {
+	return s.Override != nil && s.Override.NotificationsToSend != clientnotifications.NotifyOverrideDefault
+}
+
+// OverridesPreviews returns true if the service doesn't use the workspace setting for preview notifications
+func (s *ServiceNotifications) OverridesPreviews() bool {
+	return s.Override != nil && s.Override.PreviewNotificationsEnabled != clientnotifications.NotifyPreviewOverrideDefault
+}
+
+// Effective applies a service override to the workspace settings. Nothing is sent when both email and Slack are
+// turned off for the workspace, whatever the override says.
+func Effective(settings *clientnotifications.NotificationSetting, override *clientnotifications.NotificationOverride) (clientnotifications.NotifySettingV2, bool) {
+	notify, previews := settings.NotificationsToSend, settings.PreviewNotificationsEnabled
+
+	if override != nil {
+		if override.NotificationsToSend != clientnotifications.NotifyOverrideDefault {
+			notify = clientnotifications.NotifySettingV2(override.NotificationsToSend)
+		}
+		switch override.PreviewNotificationsEnabled {
+		case clientnotifications.NotifyPreviewOverrideTrue:
+			previews = true
+		case clientnotifications.NotifyPreviewOverrideFalse:
+			previews = false
+		}
+	}
+
+	if !settings.EmailEnabled && !settings.SlackEnabled {
+		return clientnotifications.None, false
+	}
+	return notify, previews
+}
+
+// ForServices returns the effective notification behavior of every service, in the order the services are given
+func ForServices(settings *clientnotifications.NotificationSetting, overrides []*clientnotifications.NotificationOverride, services []*service.Model) []*ServiceNotifications {
+	byServiceID := make(map[string]*clientnotifications.NotificationOverride, len(overrides))
+	for _, o := range overrides {
+		byServiceID[o.ServiceId] = o
+	}
+
+	result := make([]*ServiceNotifications, 0, len(services))
+	for _, svc := range services {
+		override := byServiceID[svc.ID()]
+		notify, previews := Effective(settings, override)
+		result = append(result, &ServiceNotifications{
+			ServiceID:                   svc.ID(),
+			ServiceName:                 svc.Name(),
+			ServiceType:                 svc.Type(),
+			Project:                     svc.ProjectName(),
+			Environment:                 svc.EnvironmentName(),
+			IsPreview:                   svc.IsPreview(),
+			NotificationsToSend:         notify,
+			PreviewNotificationsEnabled: previews,
+			Override:                    override,
+		})
+	}
+	return result
+}
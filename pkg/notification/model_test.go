@@ -0,0 +1,104 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/notification"
+)
+
+func TestEffective(t *testing.T) {
+	settings := &clientnotifications.NotificationSetting{
+		EmailEnabled:                true,
+		NotificationsToSend:         clientnotifications.Failure,
+		PreviewNotificationsEnabled: true,
+	}
+
+	tcs := []struct {
+		name             string
+		settings         *clientnotifications.NotificationSetting
+		override         *clientnotifications.NotificationOverride
+		expectedNotify   clientnotifications.NotifySettingV2
+		expectedPreviews bool
+	}{
+		{
+			name:             "no override uses workspace settings",
+			settings:         settings,
+			expectedNotify:   clientnotifications.Failure,
+			expectedPreviews: true,
+		},
+		{
+			name:     "default override uses workspace settings",
+			settings: settings,
+			override: &clientnotifications.NotificationOverride{
+				NotificationsToSend:         clientnotifications.NotifyOverrideDefault,
+				PreviewNotificationsEnabled: clientnotifications.NotifyPreviewOverrideDefault,
+			},
+			expectedNotify:   clientnotifications.Failure,
+			expectedPreviews: true,
+		},
+		{
+			name:     "override replaces workspace settings",
+			settings: settings,
+			override: &clientnotifications.NotificationOverride{
+				NotificationsToSend:         clientnotifications.NotifyOverrideNone,
+				PreviewNotificationsEnabled: clientnotifications.NotifyPreviewOverrideFalse,
+			},
+			expectedNotify:   clientnotifications.None,
+			expectedPreviews: false,
+		},
+		{
+			name: "nothing is sent without a channel",
+			settings: &clientnotifications.NotificationSetting{
+				NotificationsToSend:         clientnotifications.All,
+				PreviewNotificationsEnabled: true,
+			},
+			override: &clientnotifications.NotificationOverride{
+				NotificationsToSend:         clientnotifications.NotifyOverrideAll,
+				PreviewNotificationsEnabled: clientnotifications.NotifyPreviewOverrideTrue,
+			},
+			expectedNotify:   clientnotifications.None,
+			expectedPreviews: false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			notify, previews := notification.Effective(tc.settings, tc.override)
+			require.Equal(t, tc.expectedNotify, notify)
+			require.Equal(t, tc.expectedPreviews, previews)
+		})
+	}
+}
+
+func TestParseOnOff(t *testing.T) {
+	v, err := notification.ParseOnOff("")
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	v, err = notification.ParseOnOff("on")
+	require.NoError(t, err)
+	require.True(t, *v)
+
+	v, err = notification.ParseOnOff("off")
+	require.NoError(t, err)
+	require.False(t, *v)
+
+	_, err = notification.ParseOnOff("maybe")
+	require.Error(t, err)
+}
+
+func TestToPreviewOverride(t *testing.T) {
+	v, err := notification.ToPreviewOverride("on")
+	require.NoError(t, err)
+	require.Equal(t, clientnotifications.NotifyPreviewOverrideTrue, v)
+
+	v, err = notification.ToPreviewOverride("default")
+	require.NoError(t, err)
+	require.Equal(t, clientnotifications.NotifyPreviewOverrideDefault, v)
+
+	_, err = notification.ToPreviewOverride("true")
+	require.Error(t, err)
+}
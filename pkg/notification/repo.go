@@ -0,0 +1,115 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+func (r *Repo) GetSettings(ctx context.Context) (*clientnotifications.NotificationSetting, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.RetrieveOwnerNotificationSettingsWithResponse(ctx, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UpdateSettings(ctx context.Context, patch clientnotifications.NotificationSettingPATCH) (*clientnotifications.NotificationSetting, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.PatchOwnerNotificationSettingsWithResponse(ctx, workspace, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// ListOverrides lists the services in the current workspace that override the workspace notification settings
+func (r *Repo) ListOverrides(ctx context.Context, params *client.ListNotificationOverridesParams) ([]*clientnotifications.NotificationOverride, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+
+	return client.ListAll(ctx, params, r.listOverridesPage)
+}
+
+func (r *Repo) listOverridesPage(ctx context.Context, params *client.ListNotificationOverridesParams) ([]*clientnotifications.NotificationOverride, *client.Cursor, error) {
+	resp, err := r.client.ListNotificationOverridesWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	overrides := make([]*clientnotifications.NotificationOverride, 0, len(res))
+	for _, overrideWithCursor := range res {
+		overrides = append(overrides, &overrideWithCursor.Override)
+	}
+
+	return overrides, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) GetServiceOverride(ctx context.Context, serviceID string) (*clientnotifications.NotificationServiceOverride, error) {
+	resp, err := r.client.RetrieveServiceNotificationOverridesWithResponse(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UpdateServiceOverride(ctx context.Context, serviceID string, patch clientnotifications.NotificationServiceOverridePATCH) (*clientnotifications.NotificationServiceOverride, error) {
+	resp, err := r.client.PatchServiceNotificationOverridesWithResponse(ctx, serviceID, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+)
+
+func Details(s *clientnotifications.NotificationSetting) string {
+	lines := []string{
+		fmt.Sprintf("%-22s %s", "Notify On:", s.NotificationsToSend),
+		fmt.Sprintf("%-22s %s", "Preview Notifications:", OnOff(s.PreviewNotificationsEnabled)),
+		fmt.Sprintf("%-22s %s", "Email:", OnOff(s.EmailEnabled)),
+		fmt.Sprintf("%-22s %s", "Slack:", OnOff(s.SlackEnabled)),
+	}
+	return strings.Join(lines, "\n")
+}
+
+func Header() []string {
+	return []string{"Service", "Type", "Preview", "Notify On", "Preview Notifications", "ID"}
+}
+
+func Row(s *ServiceNotifications) []string {
+	return []string{
+		ServiceLabel(s),
+		s.ServiceType,
+		yesNo(s.IsPreview),
+		NotifyLabel(s),
+		PreviewsLabel(s),
+		s.ServiceID,
+	}
+}
+
+// ServiceLabel returns the service name with its project and environment
+func ServiceLabel(s *ServiceNotifications) string {
+	if s.Project != "" && s.Environment != "" {
+		return s.ServiceName + " (" + s.Project + " - " + s.Environment + ")"
+	}
+	return s.ServiceName
+}
+
+// NotifyLabel returns the effective setting, marking settings that come from a service override
+func NotifyLabel(s *ServiceNotifications) string {
+	if s.OverridesNotify() {
+		return string(s.NotificationsToSend) + " (override)"
+	}
+	return string(s.NotificationsToSend)
+}
+
+// PreviewsLabel returns the effective setting, marking settings that come from a service override
+func PreviewsLabel(s *ServiceNotifications) string {
+	if s.OverridesPreviews() {
+		return OnOff(s.PreviewNotificationsEnabled) + " (override)"
+	}
+	return OnOff(s.PreviewNotificationsEnabled)
+}
+
+// OnOff formats a setting the same way on and off flags are passed
+func OnOff(b bool) string {
+	if b {
+		return On
+	}
+	return Off
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
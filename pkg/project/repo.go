@@ -66,3 +66,44 @@ func (p *Repo) GetProject(ctx context.Context, id string) (*client.Project, erro
 
 	return resp.JSON200, nil
 }
+
+func (p *Repo) CreateProject(ctx context.Context, input client.ProjectPOSTInput) (*client.Project, error) {
+	workspaceId, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	input.OwnerId = workspaceId
+
+	resp, err := p.client.CreateProjectWithResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (p *Repo) UpdateProject(ctx context.Context, id string, input client.ProjectPATCHInput) (*client.Project, error) {
+	resp, err := p.client.UpdateProjectWithResponse(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (p *Repo) DeleteProject(ctx context.Context, id string) error {
+	resp, err := p.client.DeleteProjectWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,67 @@
+package registrycredential
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/service"
+)
+
+var Registries = []string{
+	string(client.GITHUB),
+	string(client.GITLAB),
+	string(client.DOCKER),
+	string(client.GOOGLEARTIFACT),
+	string(client.AWSECR),
+}
+
+// Usage is a credential with the services that pull their image with it
+type Usage struct {
+	Credential *client.RegistryCredential `json:"credential"`
+	Services   []*service.Model           `json:"services"`
+}
+
+// WithServices matches services to the credential they reference. Every credential is returned, including ones
+// no service uses, sorted by name.
+func WithServices(creds []*client.RegistryCredential, services []*service.Model) []*Usage {
+	byID := make(map[string]*Usage, len(creds))
+	usages := make([]*Usage, 0, len(creds))
+	for _, cred := range creds {
+		u := &Usage{Credential: cred, Services: []*service.Model{}}
+		byID[cred.Id] = u
+		usages = append(usages, u)
+	}
+
+	for _, svc := range services {
+		if svc.Service.RegistryCredential == nil {
+			continue
+		}
+		if u, ok := byID[svc.Service.RegistryCredential.Id]; ok {
+			u.Services = append(u.Services, svc)
+		}
+	}
+
+	slices.SortStableFunc(usages, func(a, b *Usage) int {
+		return strings.Compare(a.Credential.Name, b.Credential.Name)
+	})
+	return usages
+}
+
+// ReadToken reads a token from r. Only the first line is used so a trailing newline from echo or a file editor
+// isn't sent as part of the token.
+func ReadToken(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	token := strings.TrimSpace(line)
+	if token == "" {
+		return "", errors.New("token is empty")
+	}
+	return token, nil
+}
@@ -0,0 +1,49 @@
+package registrycredential_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/service"
+)
+
+func TestWithServices(t *testing.T) {
+	creds := []*client.RegistryCredential{
+		{Id: "rgc-2", Name: "gitlab"},
+		{Id: "rgc-1", Name: "ghcr"},
+	}
+	services := []*service.Model{
+		{Service: &client.Service{Id: "srv-1", RegistryCredential: &client.RegistryCredentialSummary{Id: "rgc-1"}}},
+		{Service: &client.Service{Id: "srv-2"}},
+		{Service: &client.Service{Id: "srv-3", RegistryCredential: &client.RegistryCredentialSummary{Id: "rgc-1"}}},
+		{Service: &client.Service{Id: "srv-4", RegistryCredential: &client.RegistryCredentialSummary{Id: "rgc-other"}}},
+	}
+
+	usages := registrycredential.WithServices(creds, services)
+	require.Len(t, usages, 2)
+
+	require.Equal(t, "ghcr", usages[0].Credential.Name)
+	require.Len(t, usages[0].Services, 2)
+	require.Equal(t, "srv-1", usages[0].Services[0].ID())
+	require.Equal(t, "srv-3", usages[0].Services[1].ID())
+
+	require.Equal(t, "gitlab", usages[1].Credential.Name)
+	require.Empty(t, usages[1].Services)
+}
+
+func TestReadToken(t *testing.T) {
+	token, err := registrycredential.ReadToken(strings.NewReader("ghp_abc123\n"))
+	require.NoError(t, err)
+	require.Equal(t, "ghp_abc123", token)
+
+	token, err = registrycredential.ReadToken(strings.NewReader("  ghp_abc123"))
+	require.NoError(t, err)
+	require.Equal(t, "ghp_abc123", token)
+
+	_, err = registrycredential.ReadToken(strings.NewReader("\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package registrycredential
+
+import (
+	"context"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/config"
+	"github.com/renderinc/cli/pkg/pointers"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListRegistryCredentials lists the registry credentials in the current workspace, fetching every page
+func (r *Repo) ListRegistryCredentials(ctx context.Context, params *client.ListRegistryCredentialsParams) ([]*client.RegistryCredential, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+// listPage returns a page of registry credentials. The generated type doesn't include the cursor of each
+// credential, so it is read from the response body.
+func (r *Repo) listPage(ctx context.Context, params *client.ListRegistryCredentialsParams) ([]*client.RegistryCredential, *client.Cursor, error) {
+	resp, err := r.client.ListRegistryCredentialsWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	creds := make([]*client.RegistryCredential, 0, len(*resp.JSON200))
+	for i := range *resp.JSON200 {
+		creds = append(creds, &(*resp.JSON200)[i])
+	}
+	return creds, client.LastCursor(resp.Body), nil
+}
+
+func (r *Repo) GetRegistryCredential(ctx context.Context, id string) (*client.RegistryCredential, error) {
+	resp, err := r.client.RetrieveRegistryCredentialWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) CreateRegistryCredential(ctx context.Context, body client.CreateRegistryCredentialJSONRequestBody) (*client.RegistryCredential, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	body.OwnerId = workspace
+
+	resp, err := r.client.CreateRegistryCredentialWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// UpdateRegistryCredential replaces a credential. The API requires every field, including the token.
+func (r *Repo) UpdateRegistryCredential(ctx context.Context, id string, body client.UpdateRegistryCredentialJSONRequestBody) (*client.RegistryCredential, error) {
+	resp, err := r.client.UpdateRegistryCredentialWithResponse(ctx, id, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteRegistryCredential(ctx context.Context, id string) error {
+	resp, err := r.client.DeleteRegistryCredentialWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
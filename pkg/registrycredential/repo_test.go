@@ -0,0 +1,62 @@
+package registrycredential_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/registrycredential"
+)
+
+func TestListRegistryCredentials(t *testing.T) {
+	t.Setenv("RENDER_WORKSPACE", "tea-1")
+
+	const total = 150
+	var cursors []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		start := 0
+		if cursor != "" {
+			var err error
+			start, err = strconv.Atoi(cursor)
+			require.NoError(t, err)
+		}
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		require.NoError(t, err)
+
+		type item struct {
+			client.RegistryCredential
+			Cursor string `json:"cursor"`
+		}
+		var resp []item
+		for i := start; i < min(start+limit, total); i++ {
+			resp = append(resp, item{
+				RegistryCredential: client.RegistryCredential{Id: fmt.Sprintf("rgc-%d", i), Registry: client.DOCKER},
+				Cursor:             strconv.Itoa(i + 1),
+			})
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer s.Close()
+
+	c, err := client.NewClientWithResponses(s.URL)
+	require.NoError(t, err)
+
+	creds, err := registrycredential.NewRepo(c).ListRegistryCredentials(context.Background(), &client.ListRegistryCredentialsParams{})
+	require.NoError(t, err)
+
+	require.Len(t, creds, total)
+	require.Equal(t, "rgc-149", creds[total-1].Id)
+	require.Equal(t, []string{"", "100"}, cursors)
+}
@@ -0,0 +1,73 @@
+package registrycredential
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/service"
+)
+
+func Header() []string {
+	return []string{"Name", "Registry", "Username", "Updated", "ID"}
+}
+
+func Row(c *client.RegistryCredential) []string {
+	return []string{
+		c.Name,
+		string(c.Registry),
+		c.Username,
+		c.UpdatedAt.Format(time.RFC3339),
+		c.Id,
+	}
+}
+
+func UsageHeader() []string {
+	return []string{"Credential", "Registry", "Service", "Image", "Service ID"}
+}
+
+// UsageRows returns a row for each service using a credential, or a single row for unused credentials
+func UsageRows(u *Usage) [][]string {
+	if len(u.Services) == 0 {
+		return [][]string{{u.Credential.Name, string(u.Credential.Registry), "(unused)", "", ""}}
+	}
+
+	rows := make([][]string, 0, len(u.Services))
+	for _, svc := range u.Services {
+		rows = append(rows, []string{
+			u.Credential.Name,
+			string(u.Credential.Registry),
+			ServiceLabel(svc),
+			pointers.StringValue(svc.Service.ImagePath),
+			svc.ID(),
+		})
+	}
+	return rows
+}
+
+// ServiceLabel returns the service name with its project and environment
+func ServiceLabel(svc *service.Model) string {
+	if svc.ProjectName() != "" && svc.EnvironmentName() != "" {
+		return svc.Name() + " (" + svc.ProjectName() + " - " + svc.EnvironmentName() + ")"
+	}
+	return svc.Name()
+}
+
+// UsageSummary describes which services are affected by a change to the credential
+func UsageSummary(u *Usage) string {
+	if len(u.Services) == 0 {
+		return fmt.Sprintf("No services use credential %s", u.Credential.Name)
+	}
+
+	lines := []string{fmt.Sprintf("New deploys of these services will pull with the updated credential %s:", u.Credential.Name)}
+	for _, svc := range u.Services {
+		lines = append(lines, fmt.Sprintf("  %s  %s", svc.ID(), ServiceLabel(svc)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func RotateSummary(u *Usage) string {
+	return fmt.Sprintf("Rotated token for registry credential %s (%s)\n\n%s", u.Credential.Name, u.Credential.Id, UsageSummary(u))
+}
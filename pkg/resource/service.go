@@ -181,7 +181,7 @@ func (rs *Service) RestartResource(ctx context.Context, id string) error {
 	}
 
 	if strings.HasPrefix(id, cronjobResourceIDPrefix) {
-		return errors.New("cron jobs cannot be restarted")
+		return errors.New("cron jobs cannot be restarted, use `render cron run` to trigger a run")
 	}
 
 	if strings.HasPrefix(id, redisResourceIDPrefix) {
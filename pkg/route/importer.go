@@ -0,0 +1,159 @@
+package route
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/rulefile"
+)
+
+// Rule is a route as declared in a YAML or JSON file. It matches the routes section of a static site in render.yaml,
+// so rules can be copied from one to the other.
+type Rule struct {
+	Type        string `yaml:"type" json:"type"`
+	Source      string `yaml:"source" json:"source"`
+	Destination string `yaml:"destination" json:"destination"`
+}
+
+// Import holds the rules read from a file along with any rules that could not be represented on Render
+type Import struct {
+	Routes  []client.RoutePut `json:"routes"`
+	Skipped []string          `json:"skipped,omitempty"`
+}
+
+// Parse reads rules in the given format. Rules are returned in file order, which becomes their priority.
+func Parse(format string, data []byte) (*Import, error) {
+	switch format {
+	case rulefile.FormatNetlify:
+		return parseNetlify(data)
+	case rulefile.FormatYAML, rulefile.FormatJSON:
+		return parseYAML(data)
+	}
+	return nil, rulefile.UnsupportedFormatError(format)
+}
+
+func parseYAML(data []byte) (*Import, error) {
+	rules, err := rulefile.LoadList[Rule](data, "routes")
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Import{}
+	for i, rule := range rules {
+		r, err := toRoutePut(rule)
+		if err != nil {
+			return nil, fmt.Errorf("route %d: %w", i+1, err)
+		}
+		res.Routes = append(res.Routes, r)
+	}
+	return res, nil
+}
+
+func toRoutePut(rule Rule) (client.RoutePut, error) {
+	routeType := client.RouteType(strings.ToLower(rule.Type))
+	if routeType == "" {
+		routeType = client.RouteTypeRedirect
+	}
+	if routeType != client.RouteTypeRedirect && routeType != client.RouteTypeRewrite {
+		return client.RoutePut{}, fmt.Errorf("type must be %q or %q, got %q", client.RouteTypeRedirect, client.RouteTypeRewrite, rule.Type)
+	}
+	if !strings.HasPrefix(rule.Source, "/") {
+		return client.RoutePut{}, fmt.Errorf("source must be a path starting with /, got %q", rule.Source)
+	}
+	if rule.Destination == "" {
+		return client.RoutePut{}, errors.New("destination is required")
+	}
+
+	return client.RoutePut{
+		Type:        routeType,
+		Source:      rule.Source,
+		Destination: rule.Destination,
+	}, nil
+}
+
+// parseNetlify reads a Netlify _redirects file. Each line is "source destination [status][!] [conditions]".
+// A 200 status is a rewrite, 3xx statuses are redirects. Rules that Render can't represent, such as custom
+// status codes, query parameter matching or conditions, are skipped and reported instead of failing the import.
+func parseNetlify(data []byte) (*Import, error) {
+	res := &Import{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: expected a source and destination: %s", lineNum, line)
+		}
+
+		skip := func(reason string) {
+			res.Skipped = append(res.Skipped, fmt.Sprintf("line %d: %s (%s)", lineNum, line, reason))
+		}
+
+		source, rest := fields[0], fields[1:]
+		if isQueryMatch(rest[0]) {
+			skip("query parameter matching is not supported")
+			continue
+		}
+		destination, rest := rest[0], rest[1:]
+
+		routeType := client.RouteTypeRedirect
+		if len(rest) > 0 {
+			status, err := strconv.Atoi(strings.TrimSuffix(rest[0], "!"))
+			if err != nil {
+				skip(fmt.Sprintf("invalid status %q", rest[0]))
+				continue
+			}
+			rest = rest[1:]
+
+			switch {
+			case status == 200:
+				routeType = client.RouteTypeRewrite
+			case status >= 300 && status < 400:
+				routeType = client.RouteTypeRedirect
+			default:
+				skip(fmt.Sprintf("status %d is not supported", status))
+				continue
+			}
+		}
+
+		if len(rest) > 0 {
+			skip("conditions are not supported")
+			continue
+		}
+
+		r, err := toRoutePut(Rule{Type: string(routeType), Source: source, Destination: destination})
+		if err != nil {
+			skip(err.Error())
+			continue
+		}
+		res.Routes = append(res.Routes, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// isQueryMatch reports whether a token after the source is Netlify query parameter matching, e.g. id=:id, rather
+// than a destination that happens to have a query string
+func isQueryMatch(token string) bool {
+	return strings.Contains(token, "=") && !strings.HasPrefix(token, "/") && !strings.Contains(token, "://")
+}
+
+// ApplyResult is the set of rules on the service after an import replaced them
+type ApplyResult struct {
+	Routes  []client.Route `json:"routes"`
+	Skipped []string       `json:"skipped,omitempty"`
+}
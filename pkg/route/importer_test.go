@@ -0,0 +1,97 @@
+package route_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/rulefile"
+)
+
+func TestParseNetlify(t *testing.T) {
+	data := []byte(`
+# comment
+/old       /new
+/blog/*    /news/:splat   302
+/app/*     /index.html    200!
+/gone      /              410
+/store id=:id  /blog/:id  301
+/ref       https://example.com/?ref=a  301
+/search    /find?q=all
+/us        /en-us         302  Country=us
+https://old.example.com/* https://example.com/:splat 301!
+`)
+
+	res, err := route.Parse(rulefile.FormatNetlify, data)
+	require.NoError(t, err)
+
+	assert.Equal(t, []client.RoutePut{
+		{Type: client.RouteTypeRedirect, Source: "/old", Destination: "/new"},
+		{Type: client.RouteTypeRedirect, Source: "/blog/*", Destination: "/news/:splat"},
+		{Type: client.RouteTypeRewrite, Source: "/app/*", Destination: "/index.html"},
+		{Type: client.RouteTypeRedirect, Source: "/ref", Destination: "https://example.com/?ref=a"},
+		{Type: client.RouteTypeRedirect, Source: "/search", Destination: "/find?q=all"},
+	}, res.Routes)
+
+	require.Len(t, res.Skipped, 4)
+	assert.Contains(t, res.Skipped[0], "line 6")
+	assert.Contains(t, res.Skipped[0], "status 410 is not supported")
+	assert.Contains(t, res.Skipped[1], "query parameter matching is not supported")
+	assert.Contains(t, res.Skipped[2], "conditions are not supported")
+	assert.Contains(t, res.Skipped[3], "source must be a path")
+}
+
+func TestParseNetlifyMissingDestination(t *testing.T) {
+	_, err := route.Parse(rulefile.FormatNetlify, []byte("/only-source\n"))
+	assert.ErrorContains(t, err, "line 1")
+}
+
+func TestParseYAML(t *testing.T) {
+	expected := []client.RoutePut{
+		{Type: client.RouteTypeRedirect, Source: "/old", Destination: "/new"},
+		{Type: client.RouteTypeRewrite, Source: "/*", Destination: "/index.html"},
+	}
+
+	t.Run("routes key", func(t *testing.T) {
+		res, err := route.Parse(rulefile.FormatYAML, []byte(`
+routes:
+  - source: /old
+    destination: /new
+  - type: rewrite
+    source: /*
+    destination: /index.html
+`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, res.Routes)
+	})
+
+	t.Run("top level list", func(t *testing.T) {
+		res, err := route.Parse(rulefile.FormatYAML, []byte(`
+- type: redirect
+  source: /old
+  destination: /new
+- type: rewrite
+  source: /*
+  destination: /index.html
+`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, res.Routes)
+	})
+
+	t.Run("json output of routes list", func(t *testing.T) {
+		res, err := route.Parse(rulefile.FormatJSON, []byte(`[
+  {"id": "rdr-1", "priority": 0, "type": "redirect", "source": "/old", "destination": "/new"},
+  {"id": "rdr-2", "priority": 1, "type": "rewrite", "source": "/*", "destination": "/index.html"}
+]`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, res.Routes)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := route.Parse(rulefile.FormatYAML, []byte(`[{type: proxy, source: /a, destination: /b}]`))
+		assert.ErrorContains(t, err, "route 1")
+	})
+}
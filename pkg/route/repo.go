@@ -0,0 +1,92 @@
+package route
+
+import (
+	"context"
+	"sort"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListRoutes returns the redirect and rewrite rules of a static site in priority order
+func (r *Repo) ListRoutes(ctx context.Context, serviceID string, params *client.ListRoutesParams) ([]*client.Route, error) {
+	routes, err := client.ListAll(ctx, params, func(ctx context.Context, params *client.ListRoutesParams) ([]*client.Route, *client.Cursor, error) {
+		return r.listPage(ctx, serviceID, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Priority < routes[j].Priority
+	})
+	return routes, nil
+}
+
+func (r *Repo) listPage(ctx context.Context, serviceID string, params *client.ListRoutesParams) ([]*client.Route, *client.Cursor, error) {
+	resp, err := r.client.ListRoutesWithResponse(ctx, serviceID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	routes := make([]*client.Route, 0, len(res))
+	for _, routeWithCursor := range res {
+		routes = append(routes, &routeWithCursor.Route)
+	}
+
+	return routes, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) AddRoute(ctx context.Context, serviceID string, data client.RoutePost) (*client.Route, error) {
+	resp, err := r.client.AddRouteWithResponse(ctx, serviceID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+// PutRoutes replaces all the rules of a static site with the given rules. Priority follows the order of the slice.
+func (r *Repo) PutRoutes(ctx context.Context, serviceID string, data []client.RoutePut) ([]client.Route, error) {
+	resp, err := r.client.PutRoutesWithResponse(ctx, serviceID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) DeleteRoute(ctx context.Context, serviceID, routeID string) error {
+	resp, err := r.client.DeleteRouteWithResponse(ctx, serviceID, routeID)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,35 @@
+package route
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/renderinc/cli/pkg/client"
+)
+
+func Header() []string {
+	return []string{"Priority", "Type", "Source", "Destination", "ID"}
+}
+
+func Row(r *client.Route) []string {
+	return []string{
+		strconv.Itoa(r.Priority),
+		string(r.Type),
+		r.Source,
+		r.Destination,
+		r.Id,
+	}
+}
+
+// ApplySummary describes the rules now on the service and any rules that were left out of the import
+func ApplySummary(serviceID string, res *ApplyResult) string {
+	lines := []string{fmt.Sprintf("Replaced redirect and rewrite rules for service %s with %d rules", serviceID, len(res.Routes))}
+	if len(res.Skipped) > 0 {
+		lines = append(lines, "", fmt.Sprintf("Skipped %d rules that are not supported by Render:", len(res.Skipped)))
+		for _, s := range res.Skipped {
+			lines = append(lines, "  "+s)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,56 @@
+// Package rulefile reads the files that route and header rules are imported from
+package rulefile
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatNetlify = "netlify"
+	FormatYAML    = "yaml"
+	FormatJSON    = "json"
+)
+
+var Formats = []string{FormatNetlify, FormatYAML, FormatJSON}
+
+// DetectFormat guesses the format of a rules file from its name. Files that aren't YAML or JSON are read as
+// Netlify files, which usually have no extension.
+func DetectFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".json":
+		return FormatJSON
+	}
+	return FormatNetlify
+}
+
+// UnsupportedFormatError is returned when a format isn't one of Formats
+func UnsupportedFormatError(format string) error {
+	return fmt.Errorf("unsupported format %q, must be one of %s", format, strings.Join(Formats, ", "))
+}
+
+// LoadList reads a YAML or JSON file that is either a list of rules or a document with the list under key, the way
+// the rules appear in render.yaml. JSON is valid YAML, so both are read the same way.
+func LoadList[T any](data []byte, key string) ([]T, error) {
+	var rules []T
+
+	var doc map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err == nil {
+		if node, ok := doc[key]; ok {
+			if err := node.Decode(&rules); err != nil {
+				return nil, fmt.Errorf("expected a list of %s under the %s key: %w", key, key, err)
+			}
+			return rules, nil
+		}
+	}
+
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("expected a list of %s or a document with a %s key: %w", key, key, err)
+	}
+	return rules, nil
+}
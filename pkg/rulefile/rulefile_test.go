@@ -0,0 +1,55 @@
+package rulefile_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/rulefile"
+)
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, rulefile.FormatNetlify, rulefile.DetectFormat("public/_redirects"))
+	assert.Equal(t, rulefile.FormatNetlify, rulefile.DetectFormat("_headers"))
+	assert.Equal(t, rulefile.FormatYAML, rulefile.DetectFormat("routes.yml"))
+	assert.Equal(t, rulefile.FormatYAML, rulefile.DetectFormat("routes.YAML"))
+	assert.Equal(t, rulefile.FormatJSON, rulefile.DetectFormat("routes.json"))
+}
+
+func TestLoadList(t *testing.T) {
+	type rule struct {
+		Path string `yaml:"path"`
+	}
+	expected := []rule{{Path: "/a"}, {Path: "/b"}}
+
+	t.Run("document with key", func(t *testing.T) {
+		rules, err := rulefile.LoadList[rule]([]byte("name: site\nrules:\n  - path: /a\n  - path: /b\n"), "rules")
+		require.NoError(t, err)
+		assert.Equal(t, expected, rules)
+	})
+
+	t.Run("top level list", func(t *testing.T) {
+		rules, err := rulefile.LoadList[rule]([]byte(`[{"path": "/a"}, {"path": "/b"}]`), "rules")
+		require.NoError(t, err)
+		assert.Equal(t, expected, rules)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		rules, err := rulefile.LoadList[rule]([]byte("rules: []\n"), "rules")
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+
+		rules, err = rulefile.LoadList[rule](nil, "rules")
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := rulefile.LoadList[rule]([]byte("rules: /a\n"), "rules")
+		assert.ErrorContains(t, err, "under the rules key")
+
+		_, err = rulefile.LoadList[rule]([]byte("other: 1\n"), "rules")
+		assert.ErrorContains(t, err, "a document with a rules key")
+	})
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"encoding/json"
+
 	"github.com/renderinc/cli/pkg/client"
 )
 
@@ -70,3 +72,20 @@ func (s Model) Type() string {
 		return ""
 	}
 }
+
+// IsPreview returns true if the service is a preview instance of another service. Every service details type
+// has the parent server, so it is read from the raw details rather than each typed variant.
+func (s Model) IsPreview() bool {
+	data, err := s.Service.ServiceDetails.MarshalJSON()
+	if err != nil {
+		return false
+	}
+
+	var details struct {
+		ParentServer *client.Resource `json:"parentServer"`
+	}
+	if err := json.Unmarshal(data, &details); err != nil {
+		return false
+	}
+	return details.ParentServer != nil
+}
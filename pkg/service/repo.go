@@ -139,3 +139,31 @@ func (s *Repo) RestartService(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *Repo) ListEnvVars(ctx context.Context, id string) ([]*client.EnvVar, error) {
+	return client.ListAll(ctx, &client.GetEnvVarsForServiceParams{}, func(ctx context.Context, params *client.GetEnvVarsForServiceParams) ([]*client.EnvVar, *client.Cursor, error) {
+		return s.listEnvVarsPage(ctx, id, params)
+	})
+}
+
+func (s *Repo) listEnvVarsPage(ctx context.Context, id string, params *client.GetEnvVarsForServiceParams) ([]*client.EnvVar, *client.Cursor, error) {
+	resp, err := s.client.GetEnvVarsForServiceWithResponse(ctx, id, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	envVars := make([]*client.EnvVar, 0, len(res))
+	for _, envVarWithCursor := range res {
+		envVars = append(envVars, &envVarWithCursor.EnvVar)
+	}
+
+	return envVars, &res[len(res)-1].Cursor, nil
+}
@@ -3,8 +3,21 @@ package text
 import (
 	"fmt"
 
+	"github.com/renderinc/cli/pkg/blueprint"
 	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	clientjob "github.com/renderinc/cli/pkg/client/jobs"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
 	"github.com/renderinc/cli/pkg/deploy"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/route"
 )
 
 func FormatString(s string) string {
@@ -33,3 +46,100 @@ func Deploy(serviceID string) func(dep *client.Deploy) string {
 		return FormatStringF("Created deploy %s for service %s", dep.Id, serviceID)
 	}
 }
+
+func CronJobRun(cronJobID string) func(run *client.CronJobRun) string {
+	return func(run *client.CronJobRun) string {
+		switch run.Status {
+		case client.CronJobRunStatusSuccessful:
+			return FormatStringF("Run %s succeeded for cron job %s", run.Id, cronJobID)
+		case client.CronJobRunStatusUnsuccessful:
+			return FormatStringF("Run %s failed for cron job %s", run.Id, cronJobID)
+		case client.CronJobRunStatusCanceled:
+			return FormatStringF("Run %s was canceled for cron job %s", run.Id, cronJobID)
+		}
+		return FormatStringF("Started run %s for cron job %s", run.Id, cronJobID)
+	}
+}
+
+func Job(serviceID string) func(j *clientjob.Job) string {
+	return func(j *clientjob.Job) string {
+		if j.Status == nil {
+			return FormatStringF("Created job %s for %s", j.Id, serviceID)
+		}
+
+		switch *j.Status {
+		case clientjob.Succeeded:
+			return FormatStringF("Job %s succeeded for %s", j.Id, serviceID)
+		case clientjob.Canceled:
+			return FormatStringF("Job %s was canceled for %s", j.Id, serviceID)
+		default:
+			return FormatStringF("Job %s failed for %s", j.Id, serviceID)
+		}
+	}
+}
+
+func Disk(d *clientdisks.DiskDetails) string {
+	return FormatString(disk.Details(d))
+}
+
+func DomainRecords(v []domain.WithRecords) string {
+	return FormatString(domain.Instructions(v))
+}
+
+func DomainVerification(v *domain.Verification) string {
+	return FormatString(domain.VerificationDetails(v))
+}
+
+func RouteApply(serviceID string) func(res *route.ApplyResult) string {
+	return func(res *route.ApplyResult) string {
+		return FormatString(route.ApplySummary(serviceID, res))
+	}
+}
+
+func HeaderApply(serviceID string) func(res *header.ApplyResult) string {
+	return func(res *header.ApplyResult) string {
+		return FormatString(header.ApplySummary(serviceID, res))
+	}
+}
+
+func Blueprint(b *clientblueprints.BlueprintDetail) string {
+	return FormatString(blueprint.Details(b)) + "\n" + BlueprintResourceTable(b.Resources)
+}
+
+func BlueprintValidation(res *blueprint.Result) string {
+	return FormatString(blueprint.ValidationSummary(res))
+}
+
+func BlueprintSpec(spec *blueprint.Spec) string {
+	data, err := blueprint.MarshalSpec(spec)
+	if err != nil {
+		return FormatStringF("failed to format Blueprint: %s", err)
+	}
+	return string(data)
+}
+
+func BlueprintExport(file string) func(spec *blueprint.Spec) string {
+	return func(spec *blueprint.Spec) string {
+		return FormatString(blueprint.ExportSummary(spec, file))
+	}
+}
+
+func BlueprintPlan(p *blueprint.Plan) string {
+	return FormatString(blueprint.PlanSummary(p))
+}
+
+func NotificationSettings(s *clientnotifications.NotificationSetting) string {
+	return FormatString(notification.Details(s))
+}
+
+func LogStreamSettings(s *lclient.OwnerLogStreamSetting) string {
+	return FormatString(logstream.Details(s))
+}
+
+func LogStreamTest(r *logstream.TestResult) string {
+	return FormatString(logstream.TestDetails(r))
+}
+
+func RegistryCredentialRotate(u *registrycredential.Usage) string {
+	return FormatString(registrycredential.RotateSummary(u))
+}
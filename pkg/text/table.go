@@ -3,10 +3,22 @@ package text
 import (
 	"github.com/jedib0t/go-pretty/table"
 
+	"github.com/renderinc/cli/pkg/blueprint"
 	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
 	clientjob "github.com/renderinc/cli/pkg/client/jobs"
 	"github.com/renderinc/cli/pkg/deploy"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/logs"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/maintenance"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/registrycredential"
 	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/route"
 )
 
 func ResourceTable(v []resource.Resource) string {
@@ -55,6 +67,134 @@ func EnvironmentTable(v []*client.Environment) string {
 	return FormatString(t.Render())
 }
 
+func DiskTable(v []*clientdisks.DiskDetails) string {
+	t := newTable()
+	t.AppendHeader(toRow(disk.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(disk.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func SnapshotTable(v []client.DiskSnapshot) string {
+	t := newTable()
+	t.AppendHeader(toRow(disk.SnapshotHeader()))
+	for _, r := range v {
+		t.AppendRow(toRow(disk.SnapshotRow(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func DomainTable(v []*client.CustomDomain) string {
+	t := newTable()
+	t.AppendHeader(toRow(domain.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(domain.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func RouteTable(v []*client.Route) string {
+	t := newTable()
+	t.AppendHeader(toRow(route.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(route.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func HeaderTable(v []*client.Header) string {
+	t := newTable()
+	t.AppendHeader(toRow(header.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(header.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func BlueprintTable(v []*clientblueprints.Blueprint) string {
+	t := newTable()
+	t.AppendHeader(toRow(blueprint.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(blueprint.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func BlueprintResourceTable(v []clientblueprints.ResourceRef) string {
+	t := newTable()
+	t.AppendHeader(toRow(blueprint.ResourceHeader()))
+	for _, r := range v {
+		t.AppendRow(toRow(blueprint.ResourceRow(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func BlueprintSyncTable(v []*clientblueprints.Sync) string {
+	t := newTable()
+	t.AppendHeader(toRow(blueprint.SyncHeader()))
+	for _, r := range v {
+		t.AppendRow(toRow(blueprint.SyncRow(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func MaintenanceTable(v []*maintenance.Model) string {
+	t := newTable()
+	t.AppendHeader(toRow(maintenance.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(maintenance.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func NotificationTable(v []*notification.ServiceNotifications) string {
+	t := newTable()
+	t.AppendHeader(toRow(notification.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(notification.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func LogPresetTable(v []logs.Preset) string {
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Command"})
+	for _, p := range v {
+		t.AppendRow(table.Row{p.Name, p.Command()})
+	}
+	return FormatString(t.Render())
+}
+
+func LogStreamTable(v []*logstream.ResourceLogStream) string {
+	t := newTable()
+	t.AppendHeader(toRow(logstream.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(logstream.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func RegistryCredentialTable(v []*client.RegistryCredential) string {
+	t := newTable()
+	t.AppendHeader(toRow(registrycredential.Header()))
+	for _, r := range v {
+		t.AppendRow(toRow(registrycredential.Row(r)))
+	}
+	return FormatString(t.Render())
+}
+
+func RegistryCredentialUsageTable(v []*registrycredential.Usage) string {
+	t := newTable()
+	t.AppendHeader(toRow(registrycredential.UsageHeader()))
+	for _, u := range v {
+		for _, r := range registrycredential.UsageRows(u) {
+			t.AppendRow(toRow(r))
+		}
+	}
+	return FormatString(t.Render())
+}
+
 func newTable() table.Writer {
 	t := table.NewWriter()
 	t.Style().Options.DrawBorder = false
@@ -26,10 +26,19 @@ type List[T any] struct {
 	windowWidth  int
 	maxWidth     int
 	onSelect     func(ListItem) tea.Cmd
+	keyActions   []ListKeyAction
 
 	hasMoreData bool
 }
 
+// ListKeyAction runs Function with the selected item when Key is pressed. It is the list equivalent of a table's
+// CustomOption.
+type ListKeyAction struct {
+	Key      string
+	Title    string
+	Function func(ListItem) tea.Cmd
+}
+
 type ListOption[T any] func(*List[T])
 
 func WithOnSelect[T any](onSelect func(ListItem) tea.Cmd) ListOption[T] {
@@ -38,6 +47,19 @@ func WithOnSelect[T any](onSelect func(ListItem) tea.Cmd) ListOption[T] {
 	}
 }
 
+func WithKeyActions[T any](actions []ListKeyAction) ListOption[T] {
+	return func(l *List[T]) {
+		l.keyActions = actions
+		l.list.AdditionalShortHelpKeys = func() []key.Binding {
+			var bindings []key.Binding
+			for _, action := range actions {
+				bindings = append(bindings, key.NewBinding(key.WithKeys(action.Key), key.WithHelp(action.Key, action.Title)))
+			}
+			return bindings
+		}
+	}
+}
+
 func NewList[T any](
 	title string,
 	loadData TypedCmd[[]T],
@@ -112,6 +134,14 @@ func (m *List[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, m.onSelect(selectedItem.(ListItem))
 			}
 		}
+
+		for _, action := range m.keyActions {
+			if msg.String() == action.Key {
+				if selectedItem := m.list.SelectedItem(); selectedItem != nil {
+					return m, action.Function(selectedItem.(ListItem))
+				}
+			}
+		}
 	}
 
 	var cmd tea.Cmd
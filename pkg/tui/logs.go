@@ -1,8 +1,8 @@
 package tui
 
 import (
+	"fmt"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -12,25 +12,43 @@ import (
 
 	"github.com/renderinc/cli/pkg/client"
 	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
 )
 
 type LogResult struct {
 	Logs       *client.Logs200Response
-	LogChannel <-chan *lclient.Log
+	LogChannel <-chan logs.TailEvent
+	// LoadOlder fetches the logs before Logs, or is nil if there are no older logs
+	LoadOlder func() (*LogResult, error)
+	// ShowSource prefixes logs with the instance or resource that emitted them
+	ShowSource bool
 }
 
-type LoadFunc func() (*client.Logs200Response, <-chan *lclient.Log, error)
+type LoadFunc func() (*client.Logs200Response, <-chan logs.TailEvent, error)
 
 func NewLogModel(loadFunc TypedCmd[*LogResult]) *LogModel {
 	return &LogModel{
 		help:      help.New(),
 		loadFunc:  loadFunc,
 		scrollBar: NewScrollBarModel(1, 0),
+		formatter: logs.NewFormatter(lipgloss.DefaultRenderer()),
+		search:    newLogSearch(),
 		viewport:  viewport.New(0, 0),
 		state:     logStateLoading,
 	}
 }
 
+var (
+	searchKey     = key.NewBinding(key.WithKeys("ctrl+f"), key.WithHelp("ctrl+f", "search"))
+	nextMatch     = key.NewBinding(key.WithKeys("n"), key.WithHelp("n/N", "next/prev match"))
+	prevMatch     = key.NewBinding(key.WithKeys("N"))
+	clearSearch   = key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear search"))
+	pauseFollow   = key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "pause"))
+	resumeFollow  = key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "follow"))
+	submitSearch  = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "done"))
+	searchKeyBind = []key.Binding{submitSearch, clearSearch}
+)
+
 type logState string
 
 const (
@@ -45,12 +63,22 @@ type LogModel struct {
 	viewport  viewport.Model
 	scrollBar *ScrollBarModel
 	help      help.Model
+	formatter *logs.Formatter
 
 	windowWidth  int
 	windowHeight int
 	top          int
 
-	logChan <-chan *lclient.Log
+	logChan    <-chan logs.TailEvent
+	tailFailed bool
+
+	loadOlder    func() (*LogResult, error)
+	loadingOlder bool
+
+	search logSearch
+	// paused stops new logs from scrolling the view while tailing
+	paused         bool
+	newWhilePaused int
 }
 
 type appendLogsMsg struct {
@@ -59,31 +87,69 @@ type appendLogsMsg struct {
 
 type logChanClose struct{}
 
-var timeStyle = lipgloss.NewStyle().PaddingRight(2)
+type olderLogsMsg struct {
+	result *LogResult
+	err    error
+}
+
+type tailStatusMsg struct {
+	event logs.TailEvent
+}
+
+var columnStyle = lipgloss.NewStyle().PaddingRight(2)
+
+var tailStatusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#AAAAAA"))
 
-func formatLogs(logs []lclient.Log) []string {
+func (m *LogModel) formatLogs(entries []lclient.Log) []string {
 	var formattedLogs []string
-	for _, log := range logs {
-		formattedLogs = append(formattedLogs, lipgloss.JoinHorizontal(
-			lipgloss.Top,
-			timeStyle.Render(log.Timestamp.Format(time.DateTime)),
-			log.Message,
-		))
+	for _, log := range entries {
+		// join the columns as blocks so multiline messages stay aligned
+		columns := m.formatter.Columns(&log)
+		for i := range len(columns) - 1 {
+			columns[i] = columnStyle.Render(columns[i])
+		}
+		formattedLogs = append(formattedLogs, lipgloss.JoinHorizontal(lipgloss.Top, columns...))
 	}
 
 	return formattedLogs
 }
 
-func (m *LogModel) readFromChannel(ch <-chan *lclient.Log) tea.Cmd {
+func (m *LogModel) readFromChannel(ch <-chan logs.TailEvent) tea.Cmd {
 	return func() tea.Msg {
-		select {
-		case log, ok := <-ch:
-			if !ok {
-				m.logChan = nil
-				return logChanClose{}
-			}
-			return appendLogsMsg{log: log}
+		evt, ok := <-ch
+		if !ok {
+			m.logChan = nil
+			return logChanClose{}
+		}
+		if evt, ok := evt.(logs.LogReceived); ok {
+			return appendLogsMsg{log: evt.Log}
+		}
+		return tailStatusMsg{event: evt}
+	}
+}
+
+func formatTailStatus(evt logs.TailEvent) string {
+	var status string
+	switch evt := evt.(type) {
+	case logs.Disconnected:
+		status = fmt.Sprintf("Connection lost, reconnecting in %s...", evt.RetryIn)
+	case logs.Reconnected:
+		status = "Reconnected."
+		if evt.Backfilled > 0 {
+			status = fmt.Sprintf("Reconnected, %d missed logs loaded.", evt.Backfilled)
 		}
+	case logs.TailFailed:
+		status = fmt.Sprintf("Stopped streaming logs: %v. Press 'r' to reload.", evt.Err)
+	}
+	return tailStatusStyle.Render(status)
+}
+
+func (m *LogModel) loadOlderLogs() tea.Cmd {
+	m.loadingOlder = true
+	loadOlder := m.loadOlder
+	return func() tea.Msg {
+		result, err := loadOlder()
+		return olderLogsMsg{result: result, err: err}
 	}
 }
 
@@ -97,45 +163,115 @@ func (m *LogModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds []tea.Cmd
 	)
 
+	// While a search is typed, keys edit the query instead of scrolling
+	if msg, ok := msg.(tea.KeyMsg); ok && m.search.typing {
+		return m, m.updateSearch(msg)
+	}
+
 	// Handle keyboard and mouse events in the viewport
 	m.viewport, cmd = m.viewport.Update(msg)
 	cmds = append(cmds, cmd)
 
 	switch msg := msg.(type) {
 	case LoadDataMsg[*LogResult]:
+		var opts []logs.FormatOption
+		if msg.Data.ShowSource {
+			opts = append(opts, logs.WithSource())
+		}
+		m.formatter = logs.NewFormatter(lipgloss.DefaultRenderer(), opts...)
+
 		if msg.Data.Logs != nil {
-			m.content = formatLogs(msg.Data.Logs.Logs)
+			m.content = m.formatLogs(msg.Data.Logs.Logs)
 		} else {
 			m.content = []string{}
 		}
 
 		m.logChan = msg.Data.LogChannel
+		m.tailFailed = false
+		m.loadOlder = msg.Data.LoadOlder
+		m.loadingOlder = false
 		if m.logChan != nil {
 			cmds = append(cmds, m.readFromChannel(m.logChan))
 		}
-		m.viewport.SetContent(strings.Join(m.content, "\n"))
+		m.setContent()
 		m.state = logStateLoaded
 	case logChanClose:
-		m.content = append(m.content, "Websocket connection closed, no more logs will be displayed. Press 'r' to reload.")
-		m.viewport.SetContent(strings.Join(m.content, "\n"))
+		if !m.tailFailed {
+			m.content = append(m.content, "Websocket connection closed, no more logs will be displayed. Press 'r' to reload.")
+		}
+		m.setContent()
 		m.viewport.GotoBottom()
+	case olderLogsMsg:
+		m.loadingOlder = false
+		var older []string
+		if msg.err != nil {
+			m.loadOlder = nil
+			older = []string{tailStatusStyle.Render(fmt.Sprintf("Failed to load older logs: %v", msg.err))}
+		} else {
+			m.loadOlder = msg.result.LoadOlder
+			older = m.formatLogs(msg.result.Logs.Logs)
+		}
+		if len(older) > 0 {
+			// keep the view in place as the older logs are added above it
+			m.content = append(older, m.content...)
+			m.setContent()
+			m.viewport.SetYOffset(m.viewport.YOffset + lipgloss.Height(strings.Join(older, "\n")))
+		}
+	case tailStatusMsg:
+		if _, ok := msg.event.(logs.TailFailed); ok {
+			m.tailFailed = true
+		}
+		m.content = append(m.content, formatTailStatus(msg.event))
+		m.appendContent()
+		if m.logChan != nil {
+			cmds = append(cmds, m.readFromChannel(m.logChan))
+		}
 	case appendLogsMsg:
-		m.content = append(m.content, formatLogs([]lclient.Log{*msg.log})...)
-		isAtBottom := m.viewport.AtBottom()
-		m.viewport.SetContent(strings.Join(m.content, "\n"))
-		if isAtBottom {
-			m.viewport.GotoBottom()
+		m.content = append(m.content, m.formatLogs([]lclient.Log{*msg.log})...)
+		if m.paused {
+			m.newWhilePaused++
 		}
+		m.appendContent()
 		if m.logChan != nil {
 			cmds = append(cmds, m.readFromChannel(m.logChan))
 		}
 	case tea.KeyMsg:
-		switch msg.Type {
+		if m.shouldLoadOlder() {
+			cmds = append(cmds, m.loadOlderLogs())
+		}
+		switch {
+		case key.Matches(msg, searchKey):
+			cmds = append(cmds, m.search.Start())
+			m.setViewPortSize()
+		case key.Matches(msg, nextMatch) && m.search.Active():
+			m.search.Next(1)
+			m.gotoMatch()
+		case key.Matches(msg, prevMatch) && m.search.Active():
+			m.search.Next(-1)
+			m.gotoMatch()
+		case key.Matches(msg, pauseFollow) && m.logChan != nil:
+			m.paused = !m.paused
+			if !m.paused {
+				m.newWhilePaused = 0
+				m.viewport.GotoBottom()
+			}
+			m.setViewPortSize()
 		default:
 			if k := msg.String(); k == "r" && m.logChan == nil {
 				cmds = append(cmds, tea.Batch(m.loadFunc.Unwrap()))
 			}
 		}
+	case *BackMsg:
+		if !msg.Handled && m.search.Active() {
+			msg.Handled = true
+			m.search.Clear()
+			m.setContent()
+			m.setViewPortSize()
+		}
+	}
+
+	if _, ok := msg.(tea.MouseMsg); ok && m.shouldLoadOlder() {
+		cmds = append(cmds, m.loadOlderLogs())
 	}
 
 	m.scrollBar.ScrollPercent(m.viewport.ScrollPercent())
@@ -146,6 +282,75 @@ func (m *LogModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+func (m *LogModel) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.search.Done()
+		m.setContent()
+		m.setViewPortSize()
+		return nil
+	case tea.KeyEsc:
+		m.search.Clear()
+		m.setContent()
+		m.setViewPortSize()
+		return nil
+	}
+
+	// search as the query is typed, starting from what's in view
+	cmd := m.search.Update(msg)
+	m.setContent()
+	m.search.Nearest(m.viewport.YOffset)
+	m.gotoMatch()
+	return cmd
+}
+
+// setContent shows the logs in the viewport with any search matches highlighted
+func (m *LogModel) setContent() {
+	lines := strings.Split(strings.Join(m.content, "\n"), "\n")
+	m.viewport.SetContent(strings.Join(m.search.Highlight(lines), "\n"))
+}
+
+// appendContent shows logs added to the end, and keeps the view at the bottom if it was there unless following is
+// paused
+func (m *LogModel) appendContent() {
+	isAtBottom := m.viewport.AtBottom()
+	m.setContent()
+	if isAtBottom && !m.paused {
+		m.viewport.GotoBottom()
+	}
+	if m.paused {
+		m.setViewPortSize()
+	}
+}
+
+// gotoMatch centers the current search match in the view
+func (m *LogModel) gotoMatch() {
+	m.setContent()
+	if line, ok := m.search.Line(); ok {
+		m.viewport.SetYOffset(max(0, line-m.viewport.Height/2))
+	}
+}
+
+// statusLine shows the search or that following is paused below the logs, or nothing
+func (m *LogModel) statusLine() string {
+	if m.search.Active() {
+		return m.search.View()
+	}
+	if m.paused {
+		status := "Paused, press p to follow new logs"
+		if m.newWhilePaused > 0 {
+			status = fmt.Sprintf("Paused, press p to follow %d new logs", m.newWhilePaused)
+		}
+		return tailStatusStyle.Render(status)
+	}
+	return ""
+}
+
+// shouldLoadOlder is checked after the user scrolls, so older logs are only loaded once they reach the top
+func (m *LogModel) shouldLoadOlder() bool {
+	return m.loadOlder != nil && !m.loadingOlder && m.viewport.AtTop()
+}
+
 func (m *LogModel) SetWidth(width int) {
 	m.windowWidth = width
 	m.setViewPortSize()
@@ -160,6 +365,9 @@ func (m *LogModel) setViewPortSize() {
 	scrollBarWidth := 1
 
 	m.viewport.Height = m.windowHeight
+	if m.statusLine() != "" {
+		m.viewport.Height--
+	}
 	m.viewport.YPosition = 0
 	m.viewport.Width = m.windowWidth - scrollBarWidth
 
@@ -167,7 +375,27 @@ func (m *LogModel) setViewPortSize() {
 }
 
 func (m *LogModel) KeyBinds() []key.Binding {
-	return (&keyMapWrapper{m.viewport.KeyMap}).ShortHelp()
+	if m.search.typing {
+		return searchKeyBind
+	}
+
+	keys := append((&keyMapWrapper{m.viewport.KeyMap}).ShortHelp(), searchKey)
+	if m.search.Active() {
+		keys = append(keys, nextMatch, clearSearch)
+	}
+	if m.logChan != nil {
+		if m.paused {
+			keys = append(keys, resumeFollow)
+		} else {
+			keys = append(keys, pauseFollow)
+		}
+	}
+	return keys
+}
+
+// IsSearching is true while a search query is typed, so keys shouldn't be handled by the view around the logs
+func (m *LogModel) IsSearching() bool {
+	return m.search.typing
 }
 
 func (m *LogModel) View() string {
@@ -190,6 +418,9 @@ func (m *LogModel) View() string {
 		m.scrollBar.View(),
 	)
 
+	if status := m.statusLine(); status != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, logView, status)
+	}
 	return logView
 }
 
@@ -3,6 +3,7 @@ package tui_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/renderinc/cli/pkg/client"
 	lclient "github.com/renderinc/cli/pkg/client/logs"
 	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logs"
 	"github.com/renderinc/cli/pkg/tui"
 	"github.com/renderinc/cli/pkg/tui/testhelper"
 	"github.com/stretchr/testify/require"
@@ -51,16 +53,16 @@ func TestNewLogModel(t *testing.T) {
 
 	t.Run("Tails logs", func(t *testing.T) {
 		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
-			ch := make(chan *lclient.Log)
+			ch := make(chan logs.TailEvent)
 			go func() {
-				ch <- &lclient.Log{
+				ch <- logs.LogReceived{Log: &lclient.Log{
 					Timestamp: time.Now(),
 					Message:   "Hello, world!",
-				}
-				ch <- &lclient.Log{
+				}}
+				ch <- logs.LogReceived{Log: &lclient.Log{
 					Timestamp: time.Now(),
 					Message:   "Goodbye, world!",
-				}
+				}}
 				close(ch)
 			}()
 			return &tui.LogResult{
@@ -87,18 +89,18 @@ func TestNewLogModel(t *testing.T) {
 		count := 0
 		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
 			count++
-			ch := make(chan *lclient.Log)
+			ch := make(chan logs.TailEvent)
 			go func() {
 				if count == 1 {
-					ch <- &lclient.Log{
+					ch <- logs.LogReceived{Log: &lclient.Log{
 						Timestamp: time.Now(),
 						Message:   "Hello, world!",
-					}
+					}}
 				} else if count == 2 {
-					ch <- &lclient.Log{
+					ch <- logs.LogReceived{Log: &lclient.Log{
 						Timestamp: time.Now(),
 						Message:   "Goodbye, world!",
-					}
+					}}
 				}
 				close(ch)
 			}()
@@ -130,6 +132,181 @@ func TestNewLogModel(t *testing.T) {
 		require.Equal(t, 2, count)
 	})
 
+	t.Run("Shows reconnects without stopping the tail", func(t *testing.T) {
+		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
+			ch := make(chan logs.TailEvent)
+			go func() {
+				ch <- logs.Disconnected{Err: errors.New("connection reset"), RetryIn: time.Second}
+				ch <- logs.LogReceived{Log: &lclient.Log{
+					Timestamp: time.Now(),
+					Message:   "Missed log",
+				}}
+				ch <- logs.Reconnected{Backfilled: 1}
+				ch <- logs.LogReceived{Log: &lclient.Log{
+					Timestamp: time.Now(),
+					Message:   "New log",
+				}}
+			}()
+			return &tui.LogResult{
+				Logs:       nil,
+				LogChannel: ch,
+			}, nil
+		}
+
+		m := tui.NewLogModel(command.LoadCmd(context.Background(), loadFunc, nil))
+		m.SetWidth(100)
+		m.SetHeight(24)
+
+		tm := teatest.NewTestModel(t, testhelper.Stackify(m))
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Connection lost, reconnecting in 1s...")) &&
+				bytes.Contains(bts, []byte("Missed log")) &&
+				bytes.Contains(bts, []byte("Reconnected, 1 missed logs loaded.")) &&
+				bytes.Contains(bts, []byte("New log"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		err := tm.Quit()
+		require.NoError(t, err)
+	})
+
+	t.Run("When tailing fails, shows the error", func(t *testing.T) {
+		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
+			ch := make(chan logs.TailEvent)
+			go func() {
+				ch <- logs.TailFailed{Err: errors.New("unauthorized")}
+				close(ch)
+			}()
+			return &tui.LogResult{
+				Logs:       nil,
+				LogChannel: ch,
+			}, nil
+		}
+
+		m := tui.NewLogModel(command.LoadCmd(context.Background(), loadFunc, nil))
+		m.SetWidth(100)
+		m.SetHeight(24)
+
+		tm := teatest.NewTestModel(t, testhelper.Stackify(m))
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Stopped streaming logs: unauthorized. Press 'r' to reload.")) &&
+				!bytes.Contains(bts, []byte("Websocket connection closed"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		err := tm.Quit()
+		require.NoError(t, err)
+	})
+
+	t.Run("Loads older logs when scrolling to the top", func(t *testing.T) {
+		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
+			return &tui.LogResult{
+				Logs: &client.Logs200Response{
+					Logs: []lclient.Log{{Timestamp: time.Now(), Message: "Newer log"}},
+				},
+				LoadOlder: func() (*tui.LogResult, error) {
+					return &tui.LogResult{
+						Logs: &client.Logs200Response{
+							Logs: []lclient.Log{{Timestamp: time.Now(), Message: "Older log"}},
+						},
+					}, nil
+				},
+			}, nil
+		}
+
+		m := tui.NewLogModel(command.LoadCmd(context.Background(), loadFunc, nil))
+		m.SetWidth(80)
+		m.SetHeight(24)
+
+		tm := teatest.NewTestModel(t, testhelper.Stackify(m))
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Newer log"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Older log"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		err := tm.Quit()
+		require.NoError(t, err)
+	})
+
+	t.Run("Searches loaded logs", func(t *testing.T) {
+		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
+			return &tui.LogResult{
+				Logs: &client.Logs200Response{
+					Logs: []lclient.Log{
+						{Timestamp: time.Now(), Message: "Hello, world!"},
+						{Timestamp: time.Now(), Message: "Something else"},
+						{Timestamp: time.Now(), Message: "Goodbye, World!"},
+					},
+				},
+			}, nil
+		}
+
+		m := tui.NewLogModel(command.LoadCmd(context.Background(), loadFunc, nil))
+		m.SetWidth(80)
+		m.SetHeight(24)
+
+		tm := teatest.NewTestModel(t, testhelper.Stackify(m))
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Goodbye, World!"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		tm.Send(tea.KeyMsg{Type: tea.KeyCtrlF})
+		tm.Type("w.rld")
+		tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("search: w.rld (1/2)"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("search: w.rld (2/2)"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		err := tm.Quit()
+		require.NoError(t, err)
+	})
+
+	t.Run("Pauses following new logs", func(t *testing.T) {
+		ch := make(chan logs.TailEvent)
+		loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
+			return &tui.LogResult{
+				Logs:       nil,
+				LogChannel: ch,
+			}, nil
+		}
+
+		m := tui.NewLogModel(command.LoadCmd(context.Background(), loadFunc, nil))
+		m.SetWidth(80)
+		m.SetHeight(24)
+
+		tm := teatest.NewTestModel(t, testhelper.Stackify(m))
+
+		ch <- logs.LogReceived{Log: &lclient.Log{Timestamp: time.Now(), Message: "Hello, world!"}}
+		tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Paused, press p to follow new logs"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		ch <- logs.LogReceived{Log: &lclient.Log{Timestamp: time.Now(), Message: "Goodbye, world!"}}
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Paused, press p to follow 1 new logs"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		err := tm.Quit()
+		require.NoError(t, err)
+	})
+
 	t.Run("Empty state", func(t *testing.T) {
 		t.Run("When not tailing", func(t *testing.T) {
 			loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
@@ -156,7 +333,7 @@ func TestNewLogModel(t *testing.T) {
 			loadFunc := func(_ context.Context, _ any) (*tui.LogResult, error) {
 				return &tui.LogResult{
 					Logs:       &client.Logs200Response{},
-					LogChannel: make(<-chan *lclient.Log),
+					LogChannel: make(<-chan logs.TailEvent),
 				}, nil
 			}
 
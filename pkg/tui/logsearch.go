@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	renderstyle "github.com/renderinc/cli/pkg/style"
+)
+
+var (
+	searchMatchStyle   = renderstyle.Highlight
+	searchCurrentStyle = renderstyle.Highlight.Bold(true).Underline(true)
+)
+
+// logSearch finds and highlights matches in the logs that are already loaded, unlike the filters which query the
+// API again
+type logSearch struct {
+	input  textinput.Model
+	typing bool
+
+	re      *regexp.Regexp
+	matches []int
+	current int
+}
+
+func newLogSearch() logSearch {
+	input := textinput.New()
+	input.Prompt = "search: "
+	input.Placeholder = "text or regex"
+	return logSearch{input: input}
+}
+
+// Active is true while a query is typed or its matches are highlighted
+func (s *logSearch) Active() bool {
+	return s.typing || s.re != nil
+}
+
+func (s *logSearch) Start() tea.Cmd {
+	s.typing = true
+	s.input.SetValue("")
+	s.setQuery("")
+	return s.input.Focus()
+}
+
+// Done stops typing and keeps the matches highlighted
+func (s *logSearch) Done() {
+	s.typing = false
+	s.input.Blur()
+	if s.re == nil {
+		s.Clear()
+	}
+}
+
+func (s *logSearch) Clear() {
+	s.typing = false
+	s.input.Blur()
+	s.setQuery("")
+}
+
+func (s *logSearch) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	s.input, cmd = s.input.Update(msg)
+	s.setQuery(s.input.Value())
+	return cmd
+}
+
+// setQuery treats the query as a regex, falling back to plain text while it isn't a valid one. Lowercase queries
+// ignore case.
+func (s *logSearch) setQuery(query string) {
+	s.matches = nil
+	s.current = 0
+	if query == "" {
+		s.re = nil
+		return
+	}
+
+	prefix := ""
+	if strings.ToLower(query) == query {
+		prefix = "(?i)"
+	}
+
+	re, err := regexp.Compile(prefix + query)
+	if err != nil {
+		re = regexp.MustCompile(prefix + regexp.QuoteMeta(query))
+	}
+	s.re = re
+}
+
+// Highlight returns the lines with matches highlighted, and records which lines match
+func (s *logSearch) Highlight(lines []string) []string {
+	s.matches = nil
+	if s.re == nil {
+		return lines
+	}
+
+	highlighted := make([]string, len(lines))
+	for i, line := range lines {
+		// match against the text without colors, so the styling doesn't affect the results
+		plain := ansi.Strip(line)
+		locs := s.re.FindAllStringIndex(plain, -1)
+		if len(locs) == 0 || locs[0][0] == locs[0][1] {
+			highlighted[i] = line
+			continue
+		}
+
+		matchStyle := searchMatchStyle
+		if len(s.matches) == s.current {
+			matchStyle = searchCurrentStyle
+		}
+		s.matches = append(s.matches, i)
+
+		var sb strings.Builder
+		last := 0
+		for _, loc := range locs {
+			sb.WriteString(plain[last:loc[0]])
+			sb.WriteString(matchStyle.Render(plain[loc[0]:loc[1]]))
+			last = loc[1]
+		}
+		sb.WriteString(plain[last:])
+		highlighted[i] = sb.String()
+	}
+
+	if s.current >= len(s.matches) {
+		s.current = 0
+	}
+	return highlighted
+}
+
+// Line returns the line of the current match
+func (s *logSearch) Line() (int, bool) {
+	if len(s.matches) == 0 {
+		return 0, false
+	}
+	return s.matches[s.current], true
+}
+
+// Next moves to the next match, wrapping around after the last. A negative step moves to the previous match.
+func (s *logSearch) Next(step int) {
+	if len(s.matches) == 0 {
+		return
+	}
+	s.current = (s.current + step + len(s.matches)) % len(s.matches)
+}
+
+// Nearest moves to the first match at or after line, or the first match if there are none after it
+func (s *logSearch) Nearest(line int) {
+	s.current = 0
+	for i, match := range s.matches {
+		if match >= line {
+			s.current = i
+			return
+		}
+	}
+}
+
+func (s *logSearch) View() string {
+	if s.typing {
+		return s.input.View()
+	}
+
+	count := "no matches"
+	if len(s.matches) > 0 {
+		count = fmt.Sprintf("%d/%d", s.current+1, len(s.matches))
+	}
+	return lipgloss.NewStyle().Foreground(renderstyle.ColorDeprioritized).Render(fmt.Sprintf("search: %s (%s)", s.input.Value(), count))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/evertras/bubble-table/table"
@@ -18,6 +19,11 @@ var defaultFilterCustomOption = CustomOption{
 	Title: "Search",
 }
 
+var multiSelectCustomOption = CustomOption{
+	Key:   "space",
+	Title: "Select",
+}
+
 type CustomOption struct {
 	Key      string
 	Title    string
@@ -33,6 +39,7 @@ type Table[T any] struct {
 	Model         table.Model
 	onSelect      func(rows []table.Row) tea.Cmd
 	customOptions []CustomOption
+	multiSelect   bool
 
 	headerMessage string
 	headerStyle   lipgloss.Style
@@ -54,6 +61,18 @@ func WithCustomOptions[T any](options []CustomOption) TableOption[T] {
 	}
 }
 
+// WithMultiSelect lets rows be selected with space. On enter, onSelect is called with the selected rows, or with the
+// highlighted row if none are selected.
+func WithMultiSelect[T any]() TableOption[T] {
+	return func(t *Table[T]) {
+		t.multiSelect = true
+
+		keyMap := t.Model.KeyMap()
+		keyMap.RowSelectToggle = key.NewBinding(key.WithKeys(" "))
+		t.Model = t.Model.SelectableRows(true).WithKeyMap(keyMap)
+	}
+}
+
 func WithHeader[T any](message string) TableOption[T] {
 	return func(t *Table[T]) {
 		t.headerMessage = message
@@ -121,6 +140,11 @@ func (t *Table[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// The table component doesn't expose a function to blur the filter input. We set the filter input
 			// value to the current filter value to blur it instead.
 			t.Model = t.Model.WithFilterInputValue(t.Model.GetCurrentFilter())
+			if t.multiSelect {
+				if rows := t.Model.SelectedRows(); len(rows) > 0 {
+					return t, t.onSelect(rows)
+				}
+			}
 			return t, t.onSelect([]table.Row{t.Model.HighlightedRow()})
 		default:
 			if !t.Model.GetIsFilterInputFocused() {
@@ -146,8 +170,11 @@ func (t *Table[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (t *Table[T]) View() string {
 	var footer string
-	if len(t.customOptions) > 0 {
+	if len(t.customOptions) > 0 || t.multiSelect {
 		var options []string
+		if t.multiSelect {
+			options = append(options, multiSelectCustomOption.String())
+		}
 		for _, option := range t.customOptions {
 			options = append(options, option.String())
 		}
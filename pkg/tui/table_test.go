@@ -0,0 +1,68 @@
+package tui_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/evertras/bubble-table/table"
+	"github.com/renderinc/cli/pkg/tui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableMultiSelect(t *testing.T) {
+	newTable := func(selected *[]string, opts ...tui.TableOption[string]) *tui.Table[string] {
+		tbl := tui.NewTable(
+			[]table.Column{table.NewColumn("Name", "Name", 10)},
+			func() tea.Msg { return nil },
+			func(s string) table.Row { return table.NewRow(table.RowData{"Name": s}) },
+			func(rows []table.Row) tea.Cmd {
+				*selected = nil
+				for _, row := range rows {
+					*selected = append(*selected, row.Data["Name"].(string))
+				}
+				return nil
+			},
+			opts...,
+		)
+		tbl.Update(tui.LoadDataMsg[[]string]{Data: []string{"a", "b", "c"}})
+		return tbl
+	}
+
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	t.Run("selects toggled rows", func(t *testing.T) {
+		var selected []string
+		tbl := newTable(&selected, tui.WithMultiSelect[string]())
+
+		tbl.Update(space)
+		tbl.Update(down)
+		tbl.Update(down)
+		tbl.Update(space)
+		tbl.Update(enter)
+
+		require.Equal(t, []string{"a", "c"}, selected)
+	})
+
+	t.Run("selects highlighted row when nothing is toggled", func(t *testing.T) {
+		var selected []string
+		tbl := newTable(&selected, tui.WithMultiSelect[string]())
+
+		tbl.Update(down)
+		tbl.Update(enter)
+
+		require.Equal(t, []string{"b"}, selected)
+	})
+
+	t.Run("ignores space without multi select", func(t *testing.T) {
+		var selected []string
+		tbl := newTable(&selected)
+
+		tbl.Update(space)
+		tbl.Update(down)
+		tbl.Update(enter)
+
+		require.Equal(t, []string{"b"}, selected)
+	})
+}
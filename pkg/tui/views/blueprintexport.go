@@ -0,0 +1,81 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/project"
+	"github.com/renderinc/cli/pkg/resource"
+)
+
+type BlueprintExportInput struct {
+	Project        string   `cli:"project"`
+	EnvironmentIDs []string `cli:"environment-ids"`
+	Names          []string `cli:"names"`
+	IncludeValues  bool     `cli:"include-values"`
+	IncludeSecrets bool     `cli:"include-secrets"`
+	Out            string   `cli:"out"`
+}
+
+// ExportBlueprint builds a Blueprint from the live resources of the workspace, or of a project or environments
+// if set, and writes it to the output file if one was given
+func ExportBlueprint(ctx context.Context, in BlueprintExportInput) (*blueprint.Spec, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resourceService, err := resource.NewDefaultResourceService()
+	if err != nil {
+		return nil, err
+	}
+
+	params := blueprint.ExportParams{
+		EnvironmentIDs: in.EnvironmentIDs,
+		IncludeValues:  in.IncludeValues,
+		IncludeSecrets: in.IncludeSecrets,
+		Names:          in.Names,
+	}
+	if in.Project != "" {
+		proj, err := findProject(ctx, project.NewRepo(c), in.Project)
+		if err != nil {
+			return nil, err
+		}
+		params.EnvironmentIDs = proj.EnvironmentIds
+	}
+
+	spec, err := blueprint.NewExporter(resourceService, c).Export(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Out != "" {
+		data, err := blueprint.MarshalSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(in.Out, data, 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write file: %w", err)
+		}
+	}
+
+	return spec, nil
+}
+
+// findProject finds a project in the current workspace by ID or name
+func findProject(ctx context.Context, repo *project.Repo, idOrName string) (*client.Project, error) {
+	projects, err := repo.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range projects {
+		if p.Id == idOrName || p.Name == idOrName {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("project %s not found", idOrName)
+}
@@ -0,0 +1,95 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type BlueprintListInput struct{}
+
+func LoadBlueprints(ctx context.Context, _ BlueprintListInput) ([]*clientblueprints.Blueprint, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	blueprintRepo := blueprint.NewRepo(c)
+
+	return blueprintRepo.ListBlueprints(ctx, &client.ListBlueprintsParams{})
+}
+
+type BlueprintList struct {
+	table *tui.Table[*clientblueprints.Blueprint]
+}
+
+func NewBlueprintList(ctx context.Context, input BlueprintListInput, selectBlueprint OnSelectFuncT[*clientblueprints.Blueprint], opts ...tui.TableOption[*clientblueprints.Blueprint]) *BlueprintList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 2).WithFiltered(true),
+		btable.NewColumn("Status", "Status", 10).WithFiltered(true),
+		btable.NewFlexColumn("Repo", "Repo", 3).WithFiltered(true),
+		btable.NewColumn("Branch", "Branch", 15).WithFiltered(true),
+		btable.NewColumn("Auto Sync", "Auto Sync", 10),
+		btable.NewColumn("Last Sync", "Last Sync", 25),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(b *clientblueprints.Blueprint) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Name":      b.Name,
+			"Status":    string(b.Status),
+			"Repo":      b.Repo,
+			"Branch":    b.Branch,
+			"Auto Sync": fmt.Sprintf("%t", b.AutoSync),
+			"Last Sync": pointers.TimeValue(b.LastSync),
+			"ID":        b.Id,
+			"blueprint": b, // this will be hidden in the UI, but will be used to get the blueprint when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		b, ok := rows[0].Data["blueprint"].(*clientblueprints.Blueprint)
+		if !ok {
+			return nil
+		}
+
+		return selectBlueprint(ctx, b)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadBlueprints, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &BlueprintList{
+		table: t,
+	}
+}
+
+func (bl *BlueprintList) Init() tea.Cmd {
+	return bl.table.Init()
+}
+
+func (bl *BlueprintList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return bl.table.Update(msg)
+}
+
+func (bl *BlueprintList) View() string {
+	return bl.table.View()
+}
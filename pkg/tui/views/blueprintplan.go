@@ -0,0 +1,72 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/project"
+	"github.com/renderinc/cli/pkg/resource"
+)
+
+type BlueprintPlanInput struct {
+	File           string   `cli:"arg:0"`
+	BlueprintID    string   `cli:"blueprint"`
+	Project        string   `cli:"project"`
+	EnvironmentIDs []string `cli:"environment-ids"`
+}
+
+// PlanBlueprint compares a parsed render.yaml with the live resources it describes. Deletes are only planned
+// when the live resources are scoped to a Blueprint, project or environments.
+func PlanBlueprint(ctx context.Context, in BlueprintPlanInput, desired *blueprint.Spec) (*blueprint.Plan, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resourceService, err := resource.NewDefaultResourceService()
+	if err != nil {
+		return nil, err
+	}
+
+	params := blueprint.ExportParams{EnvironmentIDs: in.EnvironmentIDs, IncludeSecrets: true}
+	includeDeletes := len(in.EnvironmentIDs) > 0
+
+	switch {
+	case in.BlueprintID != "":
+		b, err := blueprint.NewRepo(c).GetBlueprint(ctx, in.BlueprintID)
+		if err != nil {
+			return nil, err
+		}
+		params.Names = desired.Names()
+		for _, r := range b.Resources {
+			params.Names = append(params.Names, r.Name)
+		}
+		includeDeletes = true
+	case in.Project != "":
+		proj, err := findProject(ctx, project.NewRepo(c), in.Project)
+		if err != nil {
+			return nil, err
+		}
+		params.EnvironmentIDs = proj.EnvironmentIds
+		includeDeletes = true
+	case !includeDeletes:
+		params.Names = desired.Names()
+	}
+
+	if len(params.Names) > 0 {
+		slices.Sort(params.Names)
+		params.Names = slices.Compact(params.Names)
+	}
+
+	live, err := blueprint.NewExporter(resourceService, c).Export(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := blueprint.Diff(desired, live, includeDeletes)
+	plan.File = in.File
+	return plan, nil
+}
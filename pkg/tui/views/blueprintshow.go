@@ -0,0 +1,97 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type BlueprintInput struct {
+	BlueprintID string `cli:"arg:0"`
+}
+
+func LoadBlueprint(ctx context.Context, in BlueprintInput) (*clientblueprints.BlueprintDetail, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	blueprintRepo := blueprint.NewRepo(c)
+
+	return blueprintRepo.GetBlueprint(ctx, in.BlueprintID)
+}
+
+func LoadBlueprintResources(ctx context.Context, in BlueprintInput) ([]clientblueprints.ResourceRef, error) {
+	b, err := LoadBlueprint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return b.Resources, nil
+}
+
+// BlueprintResourceList shows the resources managed by a Blueprint
+type BlueprintResourceList struct {
+	table *tui.Table[clientblueprints.ResourceRef]
+}
+
+func NewBlueprintResourceList(ctx context.Context, input BlueprintInput, selectResource OnSelectFuncT[clientblueprints.ResourceRef], opts ...tui.TableOption[clientblueprints.ResourceRef]) *BlueprintResourceList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 3).WithFiltered(true),
+		btable.NewColumn("Type", "Type", 20).WithFiltered(true),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(r clientblueprints.ResourceRef) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Name":     r.Name,
+			"Type":     string(r.Type),
+			"ID":       r.Id,
+			"resource": r, // this will be hidden in the UI, but will be used to get the resource when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		r, ok := rows[0].Data["resource"].(clientblueprints.ResourceRef)
+		if !ok {
+			return nil
+		}
+
+		return selectResource(ctx, r)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadBlueprintResources, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &BlueprintResourceList{
+		table: t,
+	}
+}
+
+func (rl *BlueprintResourceList) Init() tea.Cmd {
+	return rl.table.Init()
+}
+
+func (rl *BlueprintResourceList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return rl.table.Update(msg)
+}
+
+func (rl *BlueprintResourceList) View() string {
+	return rl.table.View()
+}
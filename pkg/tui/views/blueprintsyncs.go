@@ -0,0 +1,77 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+func LoadBlueprintSyncs(ctx context.Context, in BlueprintInput) ([]*clientblueprints.Sync, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	blueprintRepo := blueprint.NewRepo(c)
+
+	return blueprintRepo.ListSyncs(ctx, in.BlueprintID, &client.ListBlueprintSyncsParams{})
+}
+
+type BlueprintSyncList struct {
+	table *tui.Table[*clientblueprints.Sync]
+}
+
+func NewBlueprintSyncList(ctx context.Context, input BlueprintInput, opts ...tui.TableOption[*clientblueprints.Sync]) *BlueprintSyncList {
+	columns := []btable.Column{
+		btable.NewColumn("Commit", "Commit", 10).WithFiltered(true),
+		btable.NewColumn("State", "State", 10).WithFiltered(true),
+		btable.NewColumn("Started", "Started", 25),
+		btable.NewColumn("Completed", "Completed", 25),
+		btable.NewColumn("Duration", "Duration", 10),
+		btable.NewFlexColumn("ID", "ID", 1).WithFiltered(true),
+	}
+
+	createRowFunc := func(s *clientblueprints.Sync) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Commit":    blueprint.ShortCommit(s.Commit.Id),
+			"State":     string(s.State),
+			"Started":   pointers.TimeValue(s.StartedAt),
+			"Completed": pointers.TimeValue(s.CompletedAt),
+			"Duration":  blueprint.SyncDuration(s),
+			"ID":        s.Id,
+		})
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadBlueprintSyncs, input),
+		createRowFunc,
+		func(rows []btable.Row) tea.Cmd { return nil },
+		opts...,
+	)
+
+	return &BlueprintSyncList{
+		table: t,
+	}
+}
+
+func (sl *BlueprintSyncList) Init() tea.Cmd {
+	return sl.table.Init()
+}
+
+func (sl *BlueprintSyncList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return sl.table.Update(msg)
+}
+
+func (sl *BlueprintSyncList) View() string {
+	return sl.table.View()
+}
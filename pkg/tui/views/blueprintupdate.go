@@ -0,0 +1,139 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/blueprint"
+	"github.com/renderinc/cli/pkg/client"
+	clientblueprints "github.com/renderinc/cli/pkg/client/blueprints"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type BlueprintDisconnectView struct {
+	model *tui.SimpleModel
+}
+
+func DisconnectBlueprint(ctx context.Context, input BlueprintInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := blueprint.NewRepo(c).DisconnectBlueprint(ctx, input.BlueprintID); err != nil {
+		return "", fmt.Errorf("failed to disconnect blueprint: %w", err)
+	}
+	return fmt.Sprintf("Blueprint %s successfully disconnected", input.BlueprintID), nil
+}
+
+func RequireConfirmationForDisconnectBlueprint(ctx context.Context, input BlueprintInput) (string, error) {
+	b, err := LoadBlueprint(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to get blueprint: %w", err)
+	}
+
+	return fmt.Sprintf(
+		"Are you sure you want to disconnect blueprint %s? Its %d resources will be kept but will no longer be updated from render.yaml.",
+		b.Name, len(b.Resources),
+	), nil
+}
+
+func NewBlueprintDisconnectView(ctx context.Context, input BlueprintInput) *BlueprintDisconnectView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, DisconnectBlueprint, input),
+		func() (string, error) { return RequireConfirmationForDisconnectBlueprint(ctx, input) },
+	))
+
+	return &BlueprintDisconnectView{
+		model: model,
+	}
+}
+
+func (v *BlueprintDisconnectView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *BlueprintDisconnectView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *BlueprintDisconnectView) View() string {
+	return v.model.View()
+}
+
+type BlueprintAutoSyncInput struct {
+	BlueprintID string `cli:"arg:0"`
+	Enabled     string `cli:"arg:1"`
+}
+
+func (in BlueprintAutoSyncInput) parseEnabled() (bool, error) {
+	switch strings.ToLower(in.Enabled) {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(in.Enabled)
+	if err != nil {
+		return false, fmt.Errorf("auto sync must be on or off, got %q", in.Enabled)
+	}
+	return enabled, nil
+}
+
+func SetBlueprintAutoSync(ctx context.Context, input BlueprintAutoSyncInput) (*clientblueprints.Blueprint, error) {
+	enabled, err := input.parseEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return blueprint.NewRepo(c).UpdateBlueprint(ctx, input.BlueprintID, clientblueprints.BlueprintPATCH{
+		AutoSync: pointers.From(enabled),
+	})
+}
+
+func AutoSyncMessage(b *clientblueprints.Blueprint) string {
+	if b.AutoSync {
+		return fmt.Sprintf("Auto sync enabled for blueprint %s. Changes to render.yaml on %s will be synced automatically.", b.Name, b.Branch)
+	}
+	return fmt.Sprintf("Auto sync disabled for blueprint %s. Changes to render.yaml must be synced manually.", b.Name)
+}
+
+type BlueprintAutoSyncView struct {
+	model *tui.SimpleModel
+}
+
+func NewBlueprintAutoSyncView(ctx context.Context, input BlueprintAutoSyncInput) *BlueprintAutoSyncView {
+	return &BlueprintAutoSyncView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input BlueprintAutoSyncInput) (string, error) {
+			b, err := SetBlueprintAutoSync(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return AutoSyncMessage(b), nil
+		}, input)),
+	}
+}
+
+func (v *BlueprintAutoSyncView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *BlueprintAutoSyncView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *BlueprintAutoSyncView) View() string {
+	return v.model.View()
+}
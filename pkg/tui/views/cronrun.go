@@ -0,0 +1,164 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/cron"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+const cronRunPollInterval = 5 * time.Second
+
+type CronRunInput struct {
+	CronJobID string `cli:"arg:0"`
+	Wait      bool   `cli:"wait"`
+}
+
+func newCronRepo() (*cron.Repo, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return cron.NewRepo(c), nil
+}
+
+func RunCronJob(ctx context.Context, input CronRunInput) (*client.CronJobRun, error) {
+	cronRepo, err := newCronRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := cronRepo.RunCronJob(ctx, input.CronJobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run cron job: %w", err)
+	}
+	return run, nil
+}
+
+// WaitForCronJobRun polls the cron job's events until the run ends. since must be before the run was triggered.
+func WaitForCronJobRun(ctx context.Context, cronJobID string, run *client.CronJobRun, since time.Time) (*client.CronJobRun, error) {
+	cronRepo, err := newCronRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		evts, err := cronRepo.ListEvents(ctx, cronJobID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		ended, err := cron.EndedRun(run, evts)
+		if err != nil {
+			return nil, err
+		}
+		if ended != nil {
+			return ended, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(cronRunPollInterval):
+		}
+	}
+}
+
+func RequireConfirmationForRunCronJob(ctx context.Context, input CronRunInput) (string, error) {
+	r, err := resource.GetResource(ctx, input.CronJobID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cron job: %w", err)
+	}
+
+	return fmt.Sprintf("Are you sure you want to run cron job %s now?", r.Name()), nil
+}
+
+type CronRunView struct {
+	run  tui.TypedCmd[*client.CronJobRun]
+	logs func() tea.Cmd
+}
+
+func NewCronRunView(ctx context.Context, input CronRunInput, logCmd func() tea.Cmd) *CronRunView {
+	return &CronRunView{
+		logs: logCmd,
+		run: command.WrapInConfirm(
+			command.LoadCmd(ctx, RunCronJob, input),
+			func() (string, error) { return RequireConfirmationForRunCronJob(ctx, input) },
+		),
+	}
+}
+
+func (v *CronRunView) Init() tea.Cmd {
+	return v.run.Unwrap()
+}
+
+func (v *CronRunView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tui.LoadDataMsg[*client.CronJobRun]:
+		return v, v.logs()
+	}
+	return v, nil
+}
+
+func (v *CronRunView) View() string {
+	return "Loading..."
+}
+
+type CronCancelInput struct {
+	CronJobID string `cli:"arg:0"`
+}
+
+func CancelCronJobRun(ctx context.Context, input CronCancelInput) (string, error) {
+	cronRepo, err := newCronRepo()
+	if err != nil {
+		return "", err
+	}
+
+	if err := cronRepo.CancelCronJobRun(ctx, input.CronJobID); err != nil {
+		return "", fmt.Errorf("failed to cancel cron job run: %w", err)
+	}
+	return fmt.Sprintf("Canceled the active run of cron job %s", input.CronJobID), nil
+}
+
+func RequireConfirmationForCancelCronJobRun(ctx context.Context, input CronCancelInput) (string, error) {
+	r, err := resource.GetResource(ctx, input.CronJobID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cron job: %w", err)
+	}
+
+	return fmt.Sprintf("Are you sure you want to cancel the active run of cron job %s?", r.Name()), nil
+}
+
+type CronCancelView struct {
+	model *tui.SimpleModel
+}
+
+func NewCronCancelView(ctx context.Context, input CronCancelInput) *CronCancelView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, CancelCronJobRun, input),
+		func() (string, error) { return RequireConfirmationForCancelCronJobRun(ctx, input) },
+	))
+
+	return &CronCancelView{
+		model: model,
+	}
+}
+
+func (v *CronCancelView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *CronCancelView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *CronCancelView) View() string {
+	return v.model.View()
+}
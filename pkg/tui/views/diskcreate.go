@@ -0,0 +1,103 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DiskCreateInput struct {
+	ServiceID string `cli:"arg:0"`
+	Name      string `cli:"name"`
+	MountPath string `cli:"mount-path"`
+	SizeGB    int    `cli:"size"`
+}
+
+func (d DiskCreateInput) Validate() error {
+	if d.ServiceID == "" {
+		return errors.New("service ID is required")
+	}
+	if d.Name == "" {
+		return errors.New("name is required")
+	}
+	if d.MountPath == "" {
+		return errors.New("mount path is required")
+	}
+	if d.SizeGB <= 0 {
+		return errors.New("size must be greater than 0 GB")
+	}
+	return nil
+}
+
+func CreateDisk(ctx context.Context, input DiskCreateInput) (*clientdisks.DiskDetails, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	return diskRepo.CreateDisk(ctx, clientdisks.DiskPOST{
+		ServiceId: input.ServiceID,
+		Name:      input.Name,
+		MountPath: input.MountPath,
+		SizeGB:    input.SizeGB,
+	})
+}
+
+type DiskCreateView struct {
+	formAction *tui.FormWithAction[*clientdisks.DiskDetails]
+}
+
+func NewDiskCreateView(
+	ctx context.Context,
+	input *DiskCreateInput,
+	cobraCmd *cobra.Command,
+	createDisk func(ctx context.Context, input DiskCreateInput) (*clientdisks.DiskDetails, error),
+	action func(d *clientdisks.DiskDetails) tea.Cmd,
+) *DiskCreateView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &DiskCreateView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					var createDiskInput DiskCreateInput
+					err := command.StructFromFormValues(values, &createDiskInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, createDisk, createDiskInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *DiskCreateView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *DiskCreateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *DiskCreateView) View() string {
+	return v.formAction.View()
+}
@@ -0,0 +1,67 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DiskDeleteInput struct {
+	DiskID string `cli:"arg:0"`
+}
+
+type DiskDeleteView struct {
+	model *tui.SimpleModel
+}
+
+func DeleteDisk(ctx context.Context, input DiskDeleteInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	if err := diskRepo.DeleteDisk(ctx, input.DiskID); err != nil {
+		return "", fmt.Errorf("failed to delete disk: %w", err)
+	}
+	return fmt.Sprintf("Disk %s successfully deleted", input.DiskID), nil
+}
+
+func RequireConfirmationForDeleteDisk(ctx context.Context, input DiskDeleteInput) (string, error) {
+	d, err := LoadDisk(ctx, DiskInput{DiskID: input.DiskID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get disk: %w", err)
+	}
+
+	return fmt.Sprintf("Are you sure you want to delete disk %s (%s)? All data on the disk will be permanently lost.", d.Name, disk.SizeValue(d.SizeGB)), nil
+}
+
+func NewDiskDeleteView(ctx context.Context, input DiskDeleteInput) *DiskDeleteView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, DeleteDisk, input),
+		func() (string, error) { return RequireConfirmationForDeleteDisk(ctx, input) },
+	))
+
+	return &DiskDeleteView{
+		model: model,
+	}
+}
+
+func (v *DiskDeleteView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DiskDeleteView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DiskDeleteView) View() string {
+	return v.model.View()
+}
@@ -0,0 +1,144 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DiskListInput struct {
+	ServiceID string `cli:"arg:0"`
+}
+
+func (d DiskListInput) ToParams() *client.ListDisksParams {
+	params := &client.ListDisksParams{}
+	if d.ServiceID != "" {
+		params.ServiceId = pointers.From([]string{d.ServiceID})
+	}
+	return params
+}
+
+func LoadDisks(ctx context.Context, in DiskListInput) ([]*clientdisks.DiskDetails, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	return diskRepo.ListDisks(ctx, in.ToParams())
+}
+
+type DiskList struct {
+	table *tui.Table[*clientdisks.DiskDetails]
+}
+
+func NewDiskList(ctx context.Context, input DiskListInput, selectDisk OnSelectFuncT[*clientdisks.DiskDetails], opts ...tui.TableOption[*clientdisks.DiskDetails]) *DiskList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 3).WithFiltered(true),
+		btable.NewFlexColumn("Mount Path", "Mount Path", 3).WithFiltered(true),
+		btable.NewColumn("Size", "Size", 8),
+		btable.NewColumn("Service", "Service", 25).WithFiltered(true),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(d *clientdisks.DiskDetails) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"ID":         d.Id,
+			"Name":       d.Name,
+			"Mount Path": d.MountPath,
+			"Size":       disk.SizeValue(d.SizeGB),
+			"Service":    pointers.StringValue(d.ServiceId),
+			"disk":       d, // this will be hidden in the UI, but will be used to get the disk when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		d, ok := rows[0].Data["disk"].(*clientdisks.DiskDetails)
+		if !ok {
+			return nil
+		}
+
+		return selectDisk(ctx, d)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadDisks, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &DiskList{
+		table: t,
+	}
+}
+
+func (dl *DiskList) Init() tea.Cmd {
+	return dl.table.Init()
+}
+
+func (dl *DiskList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return dl.table.Update(msg)
+}
+
+func (dl *DiskList) View() string {
+	return dl.table.View()
+}
+
+type DiskInput struct {
+	DiskID string `cli:"arg:0"`
+}
+
+func LoadDisk(ctx context.Context, in DiskInput) (*clientdisks.DiskDetails, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	return diskRepo.GetDisk(ctx, in.DiskID)
+}
+
+type DiskView struct {
+	model *tui.SimpleModel
+}
+
+func NewDiskView(ctx context.Context, input DiskInput) *DiskView {
+	return &DiskView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, in DiskInput) (string, error) {
+			d, err := LoadDisk(ctx, in)
+			if err != nil {
+				return "", err
+			}
+			return disk.Details(d), nil
+		}, input)),
+	}
+}
+
+func (v *DiskView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DiskView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DiskView) View() string {
+	return v.model.View()
+}
@@ -0,0 +1,107 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientdisks "github.com/renderinc/cli/pkg/client/disks"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DiskResizeInput struct {
+	DiskID string `cli:"arg:0"`
+	SizeGB int    `cli:"size"`
+}
+
+type DiskResizeView struct {
+	model *tui.SimpleModel
+}
+
+// validateResize fetches the disk and checks the requested size against its current size. Render disks
+// can only be grown, so we fail early instead of relying on the API error.
+func validateResize(ctx context.Context, diskRepo *disk.Repo, input DiskResizeInput) (*clientdisks.DiskDetails, error) {
+	if input.SizeGB <= 0 {
+		return nil, fmt.Errorf("--size must be greater than 0 GB")
+	}
+
+	d, err := diskRepo.GetDisk(ctx, input.DiskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk: %w", err)
+	}
+
+	if input.SizeGB == d.SizeGB {
+		return nil, fmt.Errorf("disk %s is already %s", d.Name, disk.SizeValue(d.SizeGB))
+	}
+	if input.SizeGB < d.SizeGB {
+		return nil, fmt.Errorf("disk size cannot be decreased: %s is currently %s", d.Name, disk.SizeValue(d.SizeGB))
+	}
+
+	return d, nil
+}
+
+func ResizeDisk(ctx context.Context, input DiskResizeInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	before, err := validateResize(ctx, diskRepo, input)
+	if err != nil {
+		return "", err
+	}
+
+	after, err := diskRepo.UpdateDisk(ctx, input.DiskID, clientdisks.DiskPATCH{SizeGB: pointers.From(input.SizeGB)})
+	if err != nil {
+		return "", fmt.Errorf("failed to resize disk: %w", err)
+	}
+
+	return fmt.Sprintf("Disk %s resized from %s to %s", after.Name, disk.SizeValue(before.SizeGB), disk.SizeValue(after.SizeGB)), nil
+}
+
+func RequireConfirmationForResizeDisk(ctx context.Context, input DiskResizeInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	d, err := validateResize(ctx, disk.NewRepo(c), input)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"Resize disk %s from %s to %s? Disk size cannot be decreased later.",
+		d.Name, disk.SizeValue(d.SizeGB), disk.SizeValue(input.SizeGB),
+	), nil
+}
+
+func NewDiskResizeView(ctx context.Context, input DiskResizeInput) *DiskResizeView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, ResizeDisk, input),
+		func() (string, error) { return RequireConfirmationForResizeDisk(ctx, input) },
+	))
+
+	return &DiskResizeView{
+		model: model,
+	}
+}
+
+func (v *DiskResizeView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DiskResizeView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DiskResizeView) View() string {
+	return v.model.View()
+}
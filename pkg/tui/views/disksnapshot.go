@@ -0,0 +1,171 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/disk"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DiskSnapshotListInput struct {
+	DiskID string `cli:"arg:0"`
+}
+
+func LoadDiskSnapshots(ctx context.Context, in DiskSnapshotListInput) ([]client.DiskSnapshot, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	return diskRepo.ListSnapshots(ctx, in.DiskID)
+}
+
+type DiskSnapshotList struct {
+	table *tui.Table[client.DiskSnapshot]
+}
+
+func NewDiskSnapshotList(ctx context.Context, input DiskSnapshotListInput, selectSnapshot OnSelectFuncT[client.DiskSnapshot], opts ...tui.TableOption[client.DiskSnapshot]) *DiskSnapshotList {
+	columns := []btable.Column{
+		btable.NewColumn("Created", "Created", 25),
+		btable.NewFlexColumn("Instance", "Instance", 2).WithFiltered(true),
+		btable.NewFlexColumn("Snapshot Key", "Snapshot Key", 4).WithFiltered(true),
+	}
+
+	createRowFunc := func(s client.DiskSnapshot) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Created":      pointers.TimeValue(s.CreatedAt),
+			"Instance":     pointers.StringValue(s.InstanceId),
+			"Snapshot Key": pointers.StringValue(s.SnapshotKey),
+			"snapshot":     s, // this will be hidden in the UI, but will be used to get the snapshot when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		s, ok := rows[0].Data["snapshot"].(client.DiskSnapshot)
+		if !ok {
+			return nil
+		}
+
+		return selectSnapshot(ctx, s)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadDiskSnapshots, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &DiskSnapshotList{
+		table: t,
+	}
+}
+
+func (sl *DiskSnapshotList) Init() tea.Cmd {
+	return sl.table.Init()
+}
+
+func (sl *DiskSnapshotList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return sl.table.Update(msg)
+}
+
+func (sl *DiskSnapshotList) View() string {
+	return sl.table.View()
+}
+
+type DiskSnapshotRestoreInput struct {
+	DiskID      string `cli:"arg:0"`
+	SnapshotKey string `cli:"snapshot-key"`
+	InstanceID  string `cli:"instance-id"`
+}
+
+func (in DiskSnapshotRestoreInput) ToBody() client.SnapshotRestorePOST {
+	return client.SnapshotRestorePOST{
+		SnapshotKey: in.SnapshotKey,
+		InstanceId:  pointers.PointerValueIfNotEmptyString(in.InstanceID),
+	}
+}
+
+type DiskSnapshotRestoreView struct {
+	model *tui.SimpleModel
+}
+
+func RestoreDiskSnapshot(ctx context.Context, input DiskSnapshotRestoreInput) (string, error) {
+	if input.SnapshotKey == "" {
+		return "", errors.New("--snapshot-key is required")
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	diskRepo := disk.NewRepo(c)
+
+	d, err := diskRepo.RestoreSnapshot(ctx, input.DiskID, input.ToBody())
+	if err != nil {
+		return "", fmt.Errorf("failed to restore snapshot: %w", err)
+	}
+	return fmt.Sprintf("Restore of snapshot %s started for disk %s", input.SnapshotKey, d.Name), nil
+}
+
+func RequireConfirmationForRestoreDiskSnapshot(ctx context.Context, input DiskSnapshotRestoreInput) (string, error) {
+	if input.SnapshotKey == "" {
+		return "", errors.New("--snapshot-key is required")
+	}
+
+	d, err := LoadDisk(ctx, DiskInput{DiskID: input.DiskID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get disk: %w", err)
+	}
+
+	target := fmt.Sprintf("disk %s (%s)", d.Name, d.MountPath)
+	if input.InstanceID != "" {
+		target += " on instance " + input.InstanceID
+	}
+
+	return strings.Join([]string{
+		"WARNING: this is a destructive action.",
+		fmt.Sprintf("Restoring snapshot %s will replace ALL current data on %s.", input.SnapshotKey, target),
+		"Any data written after the snapshot was taken will be permanently lost. Continue?",
+	}, " "), nil
+}
+
+func NewDiskSnapshotRestoreView(ctx context.Context, input DiskSnapshotRestoreInput) *DiskSnapshotRestoreView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, RestoreDiskSnapshot, input),
+		func() (string, error) { return RequireConfirmationForRestoreDiskSnapshot(ctx, input) },
+	))
+
+	return &DiskSnapshotRestoreView{
+		model: model,
+	}
+}
+
+func (v *DiskSnapshotRestoreView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DiskSnapshotRestoreView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DiskSnapshotRestoreView) View() string {
+	return v.model.View()
+}
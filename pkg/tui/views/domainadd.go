@@ -0,0 +1,84 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/service"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DomainAddInput struct {
+	ServiceID string `cli:"arg:0"`
+	Name      string `cli:"arg:1"`
+}
+
+// AddDomain adds the domain to the service and returns the DNS records needed to verify each domain Render created
+func AddDomain(ctx context.Context, input DomainAddInput) ([]domain.WithRecords, error) {
+	if input.Name == "" {
+		return nil, errors.New("domain name is required")
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	svc, err := service.NewRepo(c).GetService(ctx, input.ServiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	targetHost, err := domain.TargetHost(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := domain.NewRepo(c).CreateCustomDomain(ctx, input.ServiceID, input.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add domain: %w", err)
+	}
+
+	res := make([]domain.WithRecords, 0, len(domains))
+	for _, d := range domains {
+		res = append(res, domain.WithRecords{
+			Domain:  &d,
+			Records: domain.ExpectedRecords(&d, targetHost),
+		})
+	}
+	return res, nil
+}
+
+type DomainAddView struct {
+	model *tui.SimpleModel
+}
+
+func NewDomainAddView(ctx context.Context, input DomainAddInput) *DomainAddView {
+	return &DomainAddView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input DomainAddInput) (string, error) {
+			res, err := AddDomain(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return domain.Instructions(res), nil
+		}, input)),
+	}
+}
+
+func (v *DomainAddView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DomainAddView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DomainAddView) View() string {
+	return v.model.View()
+}
@@ -0,0 +1,91 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DomainListInput struct {
+	ServiceID string `cli:"arg:0"`
+}
+
+func LoadDomains(ctx context.Context, in DomainListInput) ([]*client.CustomDomain, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	domainRepo := domain.NewRepo(c)
+
+	return domainRepo.ListCustomDomains(ctx, in.ServiceID, &client.ListCustomDomainsParams{})
+}
+
+type DomainList struct {
+	table *tui.Table[*client.CustomDomain]
+}
+
+func NewDomainList(ctx context.Context, input DomainListInput, selectDomain OnSelectFuncT[*client.CustomDomain], opts ...tui.TableOption[*client.CustomDomain]) *DomainList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 3).WithFiltered(true),
+		btable.NewColumn("Type", "Type", 10),
+		btable.NewColumn("Status", "Status", 12).WithFiltered(true),
+		btable.NewFlexColumn("Redirects To", "Redirects To", 2),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(d *client.CustomDomain) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Name":         d.Name,
+			"Type":         string(d.DomainType),
+			"Status":       string(d.VerificationStatus),
+			"Redirects To": d.RedirectForName,
+			"ID":           d.Id,
+			"domain":       d, // this will be hidden in the UI, but will be used to get the domain when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		d, ok := rows[0].Data["domain"].(*client.CustomDomain)
+		if !ok {
+			return nil
+		}
+
+		return selectDomain(ctx, d)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadDomains, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &DomainList{
+		table: t,
+	}
+}
+
+func (dl *DomainList) Init() tea.Cmd {
+	return dl.table.Init()
+}
+
+func (dl *DomainList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return dl.table.Update(msg)
+}
+
+func (dl *DomainList) View() string {
+	return dl.table.View()
+}
@@ -0,0 +1,61 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type DomainInput struct {
+	ServiceID string `cli:"arg:0"`
+	Domain    string `cli:"arg:1"`
+}
+
+type DomainRemoveView struct {
+	model *tui.SimpleModel
+}
+
+func RemoveDomain(ctx context.Context, input DomainInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := domain.NewRepo(c).DeleteCustomDomain(ctx, input.ServiceID, input.Domain); err != nil {
+		return "", fmt.Errorf("failed to remove domain: %w", err)
+	}
+	return fmt.Sprintf("Domain %s successfully removed", input.Domain), nil
+}
+
+func RequireConfirmationForRemoveDomain(_ context.Context, input DomainInput) (string, error) {
+	return fmt.Sprintf("Are you sure you want to remove domain %s? Requests to it will no longer reach the service.", input.Domain), nil
+}
+
+func NewDomainRemoveView(ctx context.Context, input DomainInput) *DomainRemoveView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, RemoveDomain, input),
+		func() (string, error) { return RequireConfirmationForRemoveDomain(ctx, input) },
+	))
+
+	return &DomainRemoveView{
+		model: model,
+	}
+}
+
+func (v *DomainRemoveView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DomainRemoveView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DomainRemoveView) View() string {
+	return v.model.View()
+}
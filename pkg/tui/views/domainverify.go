@@ -0,0 +1,127 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/domain"
+	"github.com/renderinc/cli/pkg/service"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+const (
+	domainVerifyTimeout      = 10 * time.Minute
+	domainVerifyPollInterval = 5 * time.Second
+)
+
+type DomainVerifyInput struct {
+	ServiceID    string `cli:"arg:0"`
+	Domain       string `cli:"arg:1"`
+	SkipDNSCheck bool   `cli:"skip-dns-check"`
+}
+
+// VerifyDomain compares the domain's records in public DNS against what Render expects, asks Render to re-check
+// the domain, and waits for it to be verified. The local check fails fast with the mismatched records since
+// Render can't verify the domain until they are fixed.
+func VerifyDomain(ctx context.Context, input DomainVerifyInput) (*domain.Verification, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	domainRepo := domain.NewRepo(c)
+
+	d, err := domainRepo.GetCustomDomain(ctx, input.ServiceID, input.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain: %w", err)
+	}
+	if domain.IsVerified(d) {
+		return &domain.Verification{Domain: d}, nil
+	}
+
+	svc, err := service.NewRepo(c).GetService(ctx, input.ServiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	targetHost, err := domain.TargetHost(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := domain.CheckRecords(ctx, domain.DefaultResolver, domain.ExpectedRecords(d, targetHost))
+	if !domain.AllOK(checks) && !input.SkipDNSCheck {
+		return nil, fmt.Errorf(
+			"DNS records for %s do not match yet:\n\n%s\n\nUpdate the records with your DNS provider, or use --skip-dns-check if your DNS is already correct and the local lookup is stale",
+			d.Name, domain.Checks(checks),
+		)
+	}
+
+	if err := domainRepo.RefreshCustomDomain(ctx, input.ServiceID, d.Id); err != nil {
+		return nil, fmt.Errorf("failed to refresh domain: %w", err)
+	}
+
+	d, err = waitForDomainVerification(ctx, domainRepo, input.ServiceID, d.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Verification{Domain: d, Checks: checks}, nil
+}
+
+func waitForDomainVerification(ctx context.Context, domainRepo *domain.Repo, serviceID, domainID string) (*client.CustomDomain, error) {
+	timeoutTimer := time.NewTimer(domainVerifyTimeout)
+	defer timeoutTimer.Stop()
+
+	for {
+		d, err := domainRepo.GetCustomDomain(ctx, serviceID, domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		if domain.IsVerified(d) {
+			return d, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeoutTimer.C:
+			return nil, fmt.Errorf("timed out waiting for %s to be verified. DNS changes can take a while to propagate, try again later", d.Name)
+		case <-time.After(domainVerifyPollInterval):
+		}
+	}
+}
+
+type DomainVerifyView struct {
+	model *tui.SimpleModel
+}
+
+func NewDomainVerifyView(ctx context.Context, input DomainVerifyInput) *DomainVerifyView {
+	return &DomainVerifyView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input DomainVerifyInput) (string, error) {
+			v, err := VerifyDomain(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return domain.VerificationDetails(v), nil
+		}, input)),
+	}
+}
+
+func (v *DomainVerifyView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *DomainVerifyView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *DomainVerifyView) View() string {
+	return v.model.View()
+}
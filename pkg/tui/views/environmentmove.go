@@ -0,0 +1,155 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/environment"
+	"github.com/renderinc/cli/pkg/resource"
+	resourcetui "github.com/renderinc/cli/pkg/resource/tui"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type EnvironmentMoveInput struct {
+	EnvironmentID string   `cli:"arg:0"`
+	ResourceIDs   []string `cli:"resources"`
+}
+
+func MoveResources(ctx context.Context, input EnvironmentMoveInput) (string, error) {
+	if len(input.ResourceIDs) == 0 {
+		return "", errors.New("no resources provided, use --resources")
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	env, err := environment.NewRepo(c).AddResources(ctx, input.EnvironmentID, input.ResourceIDs)
+	if err != nil {
+		return "", fmt.Errorf("failed to move resources: %w", err)
+	}
+	return fmt.Sprintf("Moved %d resources to environment %s", len(input.ResourceIDs), env.Name), nil
+}
+
+func RequireConfirmationForMoveResources(ctx context.Context, input EnvironmentMoveInput) (string, error) {
+	if len(input.ResourceIDs) == 0 {
+		return "", errors.New("no resources provided, use --resources")
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	env, err := environment.NewRepo(c).GetEnvironment(ctx, input.EnvironmentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	return fmt.Sprintf(
+		"Are you sure you want to move %d resources to environment %s? They will be removed from their current environment.",
+		len(input.ResourceIDs), env.Name,
+	), nil
+}
+
+type EnvironmentMoveView struct {
+	model *tui.SimpleModel
+}
+
+func NewEnvironmentMoveView(ctx context.Context, input EnvironmentMoveInput) *EnvironmentMoveView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, MoveResources, input),
+		func() (string, error) { return RequireConfirmationForMoveResources(ctx, input) },
+	))
+
+	return &EnvironmentMoveView{
+		model: model,
+	}
+}
+
+func (v *EnvironmentMoveView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *EnvironmentMoveView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *EnvironmentMoveView) View() string {
+	return v.model.View()
+}
+
+// LoadMovableResources returns the resources in the workspace that aren't already in the environment
+func LoadMovableResources(ctx context.Context, input EnvironmentMoveInput) ([]resource.Resource, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	env, err := environment.NewRepo(c).GetEnvironment(ctx, input.EnvironmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	resources, err := LoadResourceData(ctx, ListResourceInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	inEnvironment := slices.Concat(env.ServiceIds, env.DatabasesIds, env.RedisIds)
+	return slices.DeleteFunc(resources, func(r resource.Resource) bool {
+		return slices.Contains(inEnvironment, r.ID())
+	}), nil
+}
+
+// ResourcePickerView lists resources that can be moved to an environment. Several resources can be selected with
+// space before pressing enter.
+type ResourcePickerView struct {
+	table *tui.Table[resource.Resource]
+}
+
+func NewResourcePickerView(ctx context.Context, input EnvironmentMoveInput, onPick func(ids []string) tea.Cmd) *ResourcePickerView {
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		var ids []string
+		for _, row := range rows {
+			if r, ok := row.Data["resource"].(resource.Resource); ok {
+				ids = append(ids, r.ID())
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return onPick(ids)
+	}
+
+	return &ResourcePickerView{
+		table: tui.NewTable(
+			resourcetui.ColumnsForResources(),
+			command.LoadCmd(ctx, LoadMovableResources, input),
+			resourcetui.RowForResource,
+			onSelect,
+			tui.WithMultiSelect[resource.Resource](),
+		),
+	}
+}
+
+func (v *ResourcePickerView) Init() tea.Cmd {
+	return v.table.Init()
+}
+
+func (v *ResourcePickerView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	_, cmd := v.table.Update(msg)
+	return v, cmd
+}
+
+func (v *ResourcePickerView) View() string {
+	return v.table.View()
+}
@@ -0,0 +1,225 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/environment"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type EnvironmentCreateInput struct {
+	ProjectID       string `cli:"arg:0"`
+	Name            string `cli:"name"`
+	ProtectedStatus string `cli:"protected-status"`
+}
+
+func CreateEnvironment(ctx context.Context, input EnvironmentCreateInput) (*client.Environment, error) {
+	if input.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	status, err := environment.ParseProtectedStatus(input.ProtectedStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	env, err := environment.NewRepo(c).CreateEnvironment(ctx, client.EnvironmentPOSTInput{
+		Name:            input.Name,
+		ProjectId:       input.ProjectID,
+		ProtectedStatus: status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create environment: %w", err)
+	}
+	return env, nil
+}
+
+type EnvironmentCreateView struct {
+	formAction *tui.FormWithAction[*client.Environment]
+}
+
+func NewEnvironmentCreateView(ctx context.Context, input *EnvironmentCreateInput, cobraCmd *cobra.Command, action func(*client.Environment) tea.Cmd) *EnvironmentCreateView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &EnvironmentCreateView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					createInput := EnvironmentCreateInput{ProjectID: input.ProjectID}
+					err := command.StructFromFormValues(values, &createInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, CreateEnvironment, createInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *EnvironmentCreateView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *EnvironmentCreateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *EnvironmentCreateView) View() string {
+	return v.formAction.View()
+}
+
+type EnvironmentUpdateInput struct {
+	EnvironmentID   string `cli:"arg:0"`
+	Name            string `cli:"name"`
+	ProtectedStatus string `cli:"protected-status"`
+}
+
+// ToPatch returns the fields to change. Empty values are left unchanged.
+func (in EnvironmentUpdateInput) ToPatch() (client.EnvironmentPATCHInput, error) {
+	status, err := environment.ParseProtectedStatus(in.ProtectedStatus)
+	if err != nil {
+		return client.EnvironmentPATCHInput{}, err
+	}
+
+	patch := client.EnvironmentPATCHInput{ProtectedStatus: status}
+	if in.Name != "" {
+		patch.Name = pointers.From(in.Name)
+	}
+
+	if patch.Name == nil && patch.ProtectedStatus == nil {
+		return client.EnvironmentPATCHInput{}, errors.New("nothing to update, set --name or --protected-status")
+	}
+	return patch, nil
+}
+
+func UpdateEnvironment(ctx context.Context, input EnvironmentUpdateInput) (*client.Environment, error) {
+	patch, err := input.ToPatch()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	env, err := environment.NewRepo(c).UpdateEnvironment(ctx, input.EnvironmentID, patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update environment: %w", err)
+	}
+	return env, nil
+}
+
+type EnvironmentUpdateView struct {
+	formAction *tui.FormWithAction[*client.Environment]
+}
+
+func NewEnvironmentUpdateView(ctx context.Context, input *EnvironmentUpdateInput, cobraCmd *cobra.Command, action func(*client.Environment) tea.Cmd) *EnvironmentUpdateView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &EnvironmentUpdateView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					updateInput := EnvironmentUpdateInput{EnvironmentID: input.EnvironmentID}
+					err := command.StructFromFormValues(values, &updateInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, UpdateEnvironment, updateInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *EnvironmentUpdateView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *EnvironmentUpdateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *EnvironmentUpdateView) View() string {
+	return v.formAction.View()
+}
+
+type EnvironmentDeleteInput struct {
+	EnvironmentID string `cli:"arg:0"`
+}
+
+func DeleteEnvironment(ctx context.Context, input EnvironmentDeleteInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := environment.NewRepo(c).DeleteEnvironment(ctx, input.EnvironmentID); err != nil {
+		return "", fmt.Errorf("failed to delete environment: %w", err)
+	}
+	return fmt.Sprintf("Environment %s successfully deleted", input.EnvironmentID), nil
+}
+
+func RequireConfirmationForDeleteEnvironment(ctx context.Context, input EnvironmentDeleteInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	env, err := environment.NewRepo(c).GetEnvironment(ctx, input.EnvironmentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	return fmt.Sprintf(
+		"Are you sure you want to delete environment %s? It has %d services, %d Postgres databases, %d Key Value instances and %d environment groups.",
+		env.Name, len(env.ServiceIds), len(env.DatabasesIds), len(env.RedisIds), len(env.EnvGroupIds),
+	), nil
+}
+
+type EnvironmentDeleteView struct {
+	model *tui.SimpleModel
+}
+
+func NewEnvironmentDeleteView(ctx context.Context, input EnvironmentDeleteInput) *EnvironmentDeleteView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, DeleteEnvironment, input),
+		func() (string, error) { return RequireConfirmationForDeleteEnvironment(ctx, input) },
+	))
+
+	return &EnvironmentDeleteView{
+		model: model,
+	}
+}
+
+func (v *EnvironmentDeleteView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *EnvironmentDeleteView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *EnvironmentDeleteView) View() string {
+	return v.model.View()
+}
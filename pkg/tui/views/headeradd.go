@@ -0,0 +1,98 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type HeaderAddInput struct {
+	ServiceID string `cli:"arg:0"`
+	Path      string `cli:"path"`
+	Name      string `cli:"name"`
+	Value     string `cli:"value"`
+}
+
+func (h HeaderAddInput) ToBody() (client.HeaderInput, error) {
+	if h.ServiceID == "" {
+		return client.HeaderInput{}, errors.New("service ID is required")
+	}
+	if !strings.HasPrefix(h.Path, "/") {
+		return client.HeaderInput{}, errors.New("path must start with /")
+	}
+	if h.Name == "" {
+		return client.HeaderInput{}, errors.New("name is required")
+	}
+
+	return client.HeaderInput{
+		Path:  h.Path,
+		Name:  h.Name,
+		Value: h.Value,
+	}, nil
+}
+
+func AddHeader(ctx context.Context, input HeaderAddInput) (*client.Header, error) {
+	body, err := input.ToBody()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return header.NewRepo(c).AddHeader(ctx, input.ServiceID, body)
+}
+
+type HeaderAddView struct {
+	formAction *tui.FormWithAction[*client.Header]
+}
+
+func NewHeaderAddView(
+	ctx context.Context,
+	input *HeaderAddInput,
+	cobraCmd *cobra.Command,
+	action func(h *client.Header) tea.Cmd,
+) *HeaderAddView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &HeaderAddView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					var addHeaderInput HeaderAddInput
+					err := command.StructFromFormValues(values, &addHeaderInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, AddHeader, addHeaderInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *HeaderAddView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *HeaderAddView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *HeaderAddView) View() string {
+	return v.formAction.View()
+}
@@ -0,0 +1,134 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/rulefile"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type HeaderApplyInput struct {
+	ServiceID  string `cli:"arg:0"`
+	File       string `cli:"file"`
+	Format     string `cli:"format"`
+	AllowEmpty bool   `cli:"allow-empty"`
+	DryRun     bool   `cli:"dry-run"`
+}
+
+func readHeaderFile(input HeaderApplyInput) ([]client.HeaderInput, error) {
+	if input.File == "" {
+		return nil, errors.New("--file is required")
+	}
+
+	data, err := os.ReadFile(input.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", input.File, err)
+	}
+
+	format := input.Format
+	if format == "" {
+		format = rulefile.DetectFormat(input.File)
+	}
+
+	headers, err := header.Parse(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", input.File, err)
+	}
+
+	// applying no rules removes every rule on the service, which is more likely a mistake than intended
+	if len(headers) == 0 && !input.AllowEmpty {
+		return nil, fmt.Errorf("%s does not define any rules, set --allow-empty to remove all rules from the service", input.File)
+	}
+	return headers, nil
+}
+
+// diffHeaders reads the desired rules from the file and compares them against the rules on the service
+func diffHeaders(ctx context.Context, input HeaderApplyInput) ([]client.HeaderInput, []header.Change, error) {
+	desired, err := readHeaderFile(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, err := LoadHeaders(ctx, HeaderListInput{ServiceID: input.ServiceID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list headers: %w", err)
+	}
+
+	return desired, header.Diff(existing, desired), nil
+}
+
+// ApplyHeaders replaces all the header rules of the service with the rules in the file. With DryRun set, only the
+// changes that would be made are returned.
+func ApplyHeaders(ctx context.Context, input HeaderApplyInput) (*header.ApplyResult, error) {
+	desired, changes, err := diffHeaders(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.DryRun {
+		return &header.ApplyResult{Changes: changes, DryRun: true}, nil
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	headers, err := header.NewRepo(c).PutHeaders(ctx, input.ServiceID, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply headers: %w", err)
+	}
+
+	return &header.ApplyResult{Headers: headers, Changes: changes}, nil
+}
+
+func RequireConfirmationForApplyHeaders(ctx context.Context, input HeaderApplyInput) (string, error) {
+	_, changes, err := diffHeaders(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s\n\nReplace the header rules on service %s with the rules from %s?", header.FormatDiff(changes), input.ServiceID, input.File), nil
+}
+
+type HeaderApplyView struct {
+	model *tui.SimpleModel
+}
+
+func NewHeaderApplyView(ctx context.Context, input HeaderApplyInput) *HeaderApplyView {
+	apply := command.LoadCmd(ctx, func(ctx context.Context, input HeaderApplyInput) (string, error) {
+		res, err := ApplyHeaders(ctx, input)
+		if err != nil {
+			return "", err
+		}
+		return header.ApplySummary(input.ServiceID, res), nil
+	}, input)
+
+	if !input.DryRun {
+		apply = command.WrapInConfirm(apply, func() (string, error) { return RequireConfirmationForApplyHeaders(ctx, input) })
+	}
+
+	return &HeaderApplyView{
+		model: tui.NewSimpleModel(apply),
+	}
+}
+
+func (v *HeaderApplyView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *HeaderApplyView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *HeaderApplyView) View() string {
+	return v.model.View()
+}
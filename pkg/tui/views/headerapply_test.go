@@ -0,0 +1,29 @@
+package views_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+func TestApplyHeadersWithoutRules(t *testing.T) {
+	for name, content := range map[string]string{
+		"empty.yaml":   "",
+		"headers.yaml": "headers: []\n",
+		"_headers":     "# only comments\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			_, err := views.ApplyHeaders(context.Background(), views.HeaderApplyInput{ServiceID: "srv-1", File: path, DryRun: true})
+			assert.ErrorContains(t, err, "--allow-empty")
+		})
+	}
+}
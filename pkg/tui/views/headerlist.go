@@ -0,0 +1,89 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type HeaderListInput struct {
+	ServiceID string `cli:"arg:0"`
+}
+
+func LoadHeaders(ctx context.Context, in HeaderListInput) ([]*client.Header, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	headerRepo := header.NewRepo(c)
+
+	return headerRepo.ListHeaders(ctx, in.ServiceID, &client.ListHeadersParams{})
+}
+
+type HeaderList struct {
+	table *tui.Table[*client.Header]
+}
+
+func NewHeaderList(ctx context.Context, input HeaderListInput, selectHeader OnSelectFuncT[*client.Header], opts ...tui.TableOption[*client.Header]) *HeaderList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Path", "Path", 2).WithFiltered(true),
+		btable.NewFlexColumn("Name", "Name", 2).WithFiltered(true),
+		btable.NewFlexColumn("Value", "Value", 4).WithFiltered(true),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(h *client.Header) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Path":   h.Path,
+			"Name":   h.Name,
+			"Value":  h.Value,
+			"ID":     h.Id,
+			"header": h, // this will be hidden in the UI, but will be used to get the header when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		h, ok := rows[0].Data["header"].(*client.Header)
+		if !ok {
+			return nil
+		}
+
+		return selectHeader(ctx, h)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadHeaders, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &HeaderList{
+		table: t,
+	}
+}
+
+func (hl *HeaderList) Init() tea.Cmd {
+	return hl.table.Init()
+}
+
+func (hl *HeaderList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return hl.table.Update(msg)
+}
+
+func (hl *HeaderList) View() string {
+	return hl.table.View()
+}
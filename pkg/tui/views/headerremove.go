@@ -0,0 +1,72 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/header"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type HeaderRemoveInput struct {
+	ServiceID string `cli:"arg:0"`
+	HeaderID  string `cli:"arg:1"`
+}
+
+type HeaderRemoveView struct {
+	model *tui.SimpleModel
+}
+
+func RemoveHeader(ctx context.Context, input HeaderRemoveInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := header.NewRepo(c).DeleteHeader(ctx, input.ServiceID, input.HeaderID); err != nil {
+		return "", fmt.Errorf("failed to remove header: %w", err)
+	}
+	return fmt.Sprintf("Header rule %s successfully removed", input.HeaderID), nil
+}
+
+func RequireConfirmationForRemoveHeader(ctx context.Context, input HeaderRemoveInput) (string, error) {
+	headers, err := LoadHeaders(ctx, HeaderListInput{ServiceID: input.ServiceID})
+	if err != nil {
+		return "", fmt.Errorf("failed to list headers: %w", err)
+	}
+
+	for _, h := range headers {
+		if h.Id == input.HeaderID {
+			return fmt.Sprintf("Are you sure you want to remove the header %s from %s?", h.Name, h.Path), nil
+		}
+	}
+
+	return "", fmt.Errorf("header rule %s not found on service %s", input.HeaderID, input.ServiceID)
+}
+
+func NewHeaderRemoveView(ctx context.Context, input HeaderRemoveInput) *HeaderRemoveView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, RemoveHeader, input),
+		func() (string, error) { return RequireConfirmationForRemoveHeader(ctx, input) },
+	))
+
+	return &HeaderRemoveView{
+		model: model,
+	}
+}
+
+func (v *HeaderRemoveView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *HeaderRemoveView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *HeaderRemoveView) View() string {
+	return v.model.View()
+}
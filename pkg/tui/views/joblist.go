@@ -35,7 +35,7 @@ type JobListView struct {
 	palette *PaletteView
 }
 
-func NewJobListView(ctx context.Context, input *JobListInput, generateCommands func(*clientjob.Job) tea.Cmd) *JobListView {
+func NewJobListView(ctx context.Context, input *JobListInput, generateCommands func(*clientjob.Job) tea.Cmd, opts ...tui.ListOption[*clientjob.Job]) *JobListView {
 	listView := &JobListView{}
 
 	onSelect := func(selectedItem tui.ListItem) tea.Cmd {
@@ -49,7 +49,7 @@ func NewJobListView(ctx context.Context, input *JobListInput, generateCommands f
 		func(j *clientjob.Job) tui.ListItem {
 			return job.NewListItem(j)
 		},
-		tui.WithOnSelect[*clientjob.Job](onSelect),
+		append([]tui.ListOption[*clientjob.Job]{tui.WithOnSelect[*clientjob.Job](onSelect)}, opts...)...,
 	)
 
 	return listView
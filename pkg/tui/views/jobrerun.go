@@ -0,0 +1,59 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientjob "github.com/renderinc/cli/pkg/client/jobs"
+	"github.com/renderinc/cli/pkg/job"
+)
+
+type JobRerunInput struct {
+	ServiceID    string `cli:"arg:0"`
+	JobID        string `cli:"arg:1"`
+	StartCommand string `cli:"start-command"`
+	PlanID       string `cli:"plan-id"`
+}
+
+// CreateInput copies the original job's command and plan, replacing any value set in the input
+func (in JobRerunInput) CreateInput(original *clientjob.Job) JobCreateInput {
+	startCommand := original.StartCommand
+	if in.StartCommand != "" {
+		startCommand = in.StartCommand
+	}
+
+	planID := original.PlanId
+	if in.PlanID != "" {
+		planID = in.PlanID
+	}
+
+	return JobCreateInput{
+		ServiceID:    in.ServiceID,
+		StartCommand: &startCommand,
+		PlanID:       &planID,
+	}
+}
+
+func LoadJobRerunInput(ctx context.Context, input JobRerunInput) (JobCreateInput, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return JobCreateInput{}, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	original, err := job.NewRepo(c).GetJob(ctx, input.ServiceID, input.JobID)
+	if err != nil {
+		return JobCreateInput{}, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return input.CreateInput(original), nil
+}
+
+func RerunJob(ctx context.Context, input JobRerunInput) (*clientjob.Job, error) {
+	createInput, err := LoadJobRerunInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateJob(ctx, createInput)
+}
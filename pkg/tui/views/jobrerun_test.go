@@ -0,0 +1,32 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clientjob "github.com/renderinc/cli/pkg/client/jobs"
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+func TestJobRerunCreateInput(t *testing.T) {
+	original := &clientjob.Job{
+		Id:           "job-1",
+		ServiceId:    "srv-1",
+		StartCommand: "rake backfill[2024-01-01,2024-02-01]",
+		PlanId:       "plan-1",
+	}
+
+	t.Run("copies the original job", func(t *testing.T) {
+		in := views.JobRerunInput{ServiceID: "srv-1", JobID: "job-1"}.CreateInput(original)
+		require.Equal(t, "srv-1", in.ServiceID)
+		require.Equal(t, original.StartCommand, *in.StartCommand)
+		require.Equal(t, "plan-1", *in.PlanID)
+	})
+
+	t.Run("overrides set values", func(t *testing.T) {
+		in := views.JobRerunInput{ServiceID: "srv-1", JobID: "job-1", PlanID: "plan-2"}.CreateInput(original)
+		require.Equal(t, original.StartCommand, *in.StartCommand)
+		require.Equal(t, "plan-2", *in.PlanID)
+	})
+}
@@ -0,0 +1,58 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientjob "github.com/renderinc/cli/pkg/client/jobs"
+	"github.com/renderinc/cli/pkg/job"
+)
+
+type JobWaitInput struct {
+	ServiceID string `cli:"arg:0"`
+	JobID     string `cli:"arg:1"`
+}
+
+func LoadJob(ctx context.Context, input JobWaitInput) (*clientjob.Job, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	j, err := job.NewRepo(c).GetJob(ctx, input.ServiceID, input.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	return j, nil
+}
+
+// WaitForJob polls the job with backoff until it reaches a terminal status
+func WaitForJob(ctx context.Context, input JobWaitInput) (*clientjob.Job, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	jobRepo := job.NewRepo(c)
+
+	var interval time.Duration
+	for {
+		j, err := jobRepo.GetJob(ctx, input.ServiceID, input.JobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get job: %w", err)
+		}
+
+		if job.IsComplete(j.Status) {
+			return j, nil
+		}
+
+		interval = job.PollBackoff.Next(interval)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logs"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+// PresetArgs returns the input as flags of the logs command, the way presets are saved
+func (l LogInput) PresetArgs() ([]string, error) {
+	return command.InputToArgs(&l)
+}
+
+func SaveLogPreset(name string, input LogInput) error {
+	args, err := input.PresetArgs()
+	if err != nil {
+		return err
+	}
+
+	if err := logs.SavePreset(name, args); err != nil {
+		return fmt.Errorf("failed to save log preset: %w", err)
+	}
+	return nil
+}
+
+func LogInputFromPreset(name string) (LogInput, error) {
+	preset, err := logs.GetPreset(name)
+	if err != nil {
+		return LogInput{}, err
+	}
+
+	var input LogInput
+	if err := command.InputFromArgs(preset.Args, &input); err != nil {
+		return LogInput{}, fmt.Errorf("invalid log preset %s: %w", name, err)
+	}
+	return input, nil
+}
+
+// presetForm holds the sidebar fields that load a saved preset or save the filters as one
+type presetForm struct {
+	load string
+	save string
+}
+
+func (p *presetForm) fields() []huh.Field {
+	options := []huh.Option[string]{huh.NewOption("none", "")}
+	// a config that can't be read just means there are no presets to pick from
+	presets, _ := logs.ListPresets()
+	for _, preset := range presets {
+		options = append(options, huh.NewOption(preset.Name, preset.Name))
+	}
+
+	return []huh.Field{
+		huh.NewSelect[string]().Key("preset").Title("preset").Description("Replace the filters with a saved preset").Options(options...).Value(&p.load),
+		huh.NewInput().Key("save").Title("save").Description("Save the filters as a preset with this name").Value(&p.save),
+	}
+}
+
+// apply loads the picked preset, keeping the current resources if the preset has none, then saves the result if a
+// name was given
+func (p *presetForm) apply(in LogInput) (LogInput, error) {
+	if p.load != "" {
+		preset, err := LogInputFromPreset(p.load)
+		if err != nil {
+			return in, err
+		}
+		if len(preset.ResourceIDs) == 0 {
+			preset.ResourceIDs = in.ResourceIDs
+		}
+		in = preset
+	}
+
+	if p.save != "" {
+		if err := SaveLogPreset(p.save, in); err != nil {
+			return in, err
+		}
+	}
+	return in, nil
+}
+
+type LogPresetListInput struct{}
+
+func LoadLogPresets(_ context.Context, _ LogPresetListInput) ([]logs.Preset, error) {
+	return logs.ListPresets()
+}
+
+type LogPresetList struct {
+	table *tui.Table[logs.Preset]
+}
+
+func NewLogPresetList(ctx context.Context, input LogPresetListInput, selectPreset OnSelectFuncT[logs.Preset], opts ...tui.TableOption[logs.Preset]) *LogPresetList {
+	columns := []btable.Column{
+		btable.NewColumn("Name", "Name", 20).WithFiltered(true),
+		btable.NewFlexColumn("Command", "Command", 1).WithFiltered(true),
+	}
+
+	createRowFunc := func(p logs.Preset) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Name":    p.Name,
+			"Command": p.Command(),
+			"preset":  p, // this will be hidden in the UI, but will be used to get the preset when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		p, ok := rows[0].Data["preset"].(logs.Preset)
+		if !ok {
+			return nil
+		}
+
+		return selectPreset(ctx, p)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadLogPresets, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &LogPresetList{
+		table: t,
+	}
+}
+
+func (l *LogPresetList) Init() tea.Cmd {
+	return l.table.Init()
+}
+
+func (l *LogPresetList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return l.table.Update(msg)
+}
+
+func (l *LogPresetList) View() string {
+	return l.table.View()
+}
+
+type LogPresetRemoveInput struct {
+	Name string `cli:"arg:0"`
+}
+
+func RemoveLogPreset(_ context.Context, input LogPresetRemoveInput) (string, error) {
+	if err := logs.DeletePreset(input.Name); err != nil {
+		return "", fmt.Errorf("failed to remove log preset: %w", err)
+	}
+	return fmt.Sprintf("Removed log preset %s", input.Name), nil
+}
+
+func RequireConfirmationForRemoveLogPreset(_ context.Context, input LogPresetRemoveInput) (string, error) {
+	return fmt.Sprintf("Are you sure you want to remove log preset %s?", input.Name), nil
+}
+
+type LogPresetRemoveView struct {
+	model *tui.SimpleModel
+}
+
+func NewLogPresetRemoveView(ctx context.Context, input LogPresetRemoveInput) *LogPresetRemoveView {
+	return &LogPresetRemoveView{
+		model: tui.NewSimpleModel(command.WrapInConfirm(
+			command.LoadCmd(ctx, RemoveLogPreset, input),
+			func() (string, error) { return RequireConfirmationForRemoveLogPreset(ctx, input) },
+		)),
+	}
+}
+
+func (v *LogPresetRemoveView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *LogPresetRemoveView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *LogPresetRemoveView) View() string {
+	return v.model.View()
+}
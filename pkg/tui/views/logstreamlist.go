@@ -0,0 +1,265 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/renderinc/cli/pkg/client"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type LogStreamSettingsInput struct{}
+
+func LoadLogStreamSettings(ctx context.Context, _ LogStreamSettingsInput) (*lclient.OwnerLogStreamSetting, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return logstream.NewRepo(c).GetSettings(ctx)
+}
+
+type LogStreamSettingsView struct {
+	model *tui.SimpleModel
+}
+
+func NewLogStreamSettingsView(ctx context.Context, input LogStreamSettingsInput) *LogStreamSettingsView {
+	return &LogStreamSettingsView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input LogStreamSettingsInput) (string, error) {
+			settings, err := LoadLogStreamSettings(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return logstream.Details(settings), nil
+		}, input)),
+	}
+}
+
+func (v *LogStreamSettingsView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *LogStreamSettingsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *LogStreamSettingsView) View() string {
+	return v.model.View()
+}
+
+type LogStreamListInput struct {
+	EnvironmentIDs []string `cli:"environment-ids"`
+	Setting        string   `cli:"setting"`
+	OverridesOnly  bool     `cli:"overrides-only"`
+}
+
+// LoadResourceLogStreams returns where the logs of every resource in the workspace go, including preview services
+func LoadResourceLogStreams(ctx context.Context, in LogStreamListInput) ([]*logstream.ResourceLogStream, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resourceService, err := resource.NewDefaultResourceService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource service: %w", err)
+	}
+
+	repo := logstream.NewRepo(c)
+
+	var settings *lclient.OwnerLogStreamSetting
+	var overrides []*lclient.ResourceLogStreamSetting
+	var resources []resource.Resource
+
+	wg, gctx := errgroup.WithContext(ctx)
+	wg.Go(func() error {
+		var err error
+		settings, err = repo.GetSettings(gctx)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		resources, err = resourceService.ListResources(gctx, resource.ResourceParams{EnvironmentIDs: in.EnvironmentIDs, IncludePreviews: true})
+		if err != nil {
+			return err
+		}
+
+		resourceIDs := make([]string, 0, len(resources))
+		for _, r := range resources {
+			resourceIDs = append(resourceIDs, r.ID())
+		}
+		overrides, err = repo.ListOverrides(gctx, resourceIDs)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := logstream.ForResources(settings, overrides, resources)
+	if in.Setting == "" && !in.OverridesOnly {
+		return result, nil
+	}
+
+	filtered := make([]*logstream.ResourceLogStream, 0, len(result))
+	for _, s := range result {
+		if in.Setting != "" && string(s.Setting) != in.Setting {
+			continue
+		}
+		if in.OverridesOnly && !s.IsOverride() {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+	return filtered, nil
+}
+
+type LogStreamList struct {
+	table *tui.Table[*logstream.ResourceLogStream]
+}
+
+func NewLogStreamList(ctx context.Context, input LogStreamListInput, selectResource OnSelectFuncT[*logstream.ResourceLogStream], opts ...tui.TableOption[*logstream.ResourceLogStream]) *LogStreamList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Resource", "Resource", 3).WithFiltered(true),
+		btable.NewColumn("Type", "Type", 18).WithFiltered(true),
+		btable.NewColumn("Setting", "Setting", 16).WithFiltered(true),
+		btable.NewFlexColumn("Endpoint", "Endpoint", 2).WithFiltered(true),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(s *logstream.ResourceLogStream) btable.Row {
+		row := logstream.Row(s)
+		return btable.NewRow(btable.RowData{
+			"ID":        s.ResourceID,
+			"Resource":  row[0],
+			"Type":      row[1],
+			"Setting":   row[2],
+			"Endpoint":  row[3],
+			"logStream": s, // this will be hidden in the UI, but will be used to get the resource when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		s, ok := rows[0].Data["logStream"].(*logstream.ResourceLogStream)
+		if !ok {
+			return nil
+		}
+
+		return selectResource(ctx, s)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadResourceLogStreams, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &LogStreamList{
+		table: t,
+	}
+}
+
+func (l *LogStreamList) Init() tea.Cmd {
+	return l.table.Init()
+}
+
+func (l *LogStreamList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return l.table.Update(msg)
+}
+
+func (l *LogStreamList) View() string {
+	return l.table.View()
+}
+
+type LogStreamTestInput struct {
+	ResourceID string `cli:"arg:0"`
+	Endpoint   string `cli:"endpoint"`
+}
+
+// TestLogStream connects to the endpoint from the input, or else to where the resource's logs go, or else to the
+// workspace log stream
+func TestLogStream(ctx context.Context, input LogStreamTestInput) (*logstream.TestResult, error) {
+	endpoint, err := logStreamTestEndpoint(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return logstream.TestEndpoint(ctx, endpoint, nil)
+}
+
+func logStreamTestEndpoint(ctx context.Context, input LogStreamTestInput) (string, error) {
+	if input.Endpoint != "" {
+		return input.Endpoint, nil
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := logstream.NewRepo(c)
+
+	if input.ResourceID != "" {
+		override, err := repo.GetOverride(ctx, input.ResourceID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get log stream override: %w", err)
+		}
+		if override != nil && override.Setting != nil {
+			if *override.Setting == lclient.LogStreamSettingDrop || override.Endpoint == nil {
+				return "", fmt.Errorf("%s drops its logs, there is no endpoint to test", input.ResourceID)
+			}
+			return *override.Endpoint, nil
+		}
+	}
+
+	settings, err := repo.GetSettings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get log stream: %w", err)
+	}
+	if settings == nil || settings.Endpoint == nil || *settings.Endpoint == "" {
+		return "", errors.New("no log stream is configured for this workspace, pass --endpoint to test one")
+	}
+	return *settings.Endpoint, nil
+}
+
+type LogStreamTestView struct {
+	model *tui.SimpleModel
+}
+
+func NewLogStreamTestView(ctx context.Context, input LogStreamTestInput) *LogStreamTestView {
+	return &LogStreamTestView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input LogStreamTestInput) (string, error) {
+			result, err := TestLogStream(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return logstream.TestDetails(result), nil
+		}, input)),
+	}
+}
+
+func (v *LogStreamTestView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *LogStreamTestView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *LogStreamTestView) View() string {
+	return v.model.View()
+}
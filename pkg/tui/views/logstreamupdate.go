@@ -0,0 +1,355 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/logstream"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type LogStreamSetInput struct {
+	Endpoint  string `cli:"endpoint"`
+	TokenFile string `cli:"token-file"`
+	Preview   string `cli:"preview"`
+}
+
+// readLogStreamToken returns the token from the form, or reads it from tokenFile. Log stream tokens are optional, so
+// nothing is read when neither is set.
+func readLogStreamToken(token, tokenFile string) (string, error) {
+	if token == "" && tokenFile == "" {
+		return "", nil
+	}
+	return tokenFromForm(token, tokenFile)
+}
+
+// ToUpdate returns the new workspace log stream. Empty fields and an empty token keep their current value.
+func (in LogStreamSetInput) ToUpdate(current *lclient.OwnerLogStreamSetting, token string) (lclient.LogStreamOwnerUpdate, error) {
+	update := lclient.LogStreamOwnerUpdate{Preview: lclient.LogStreamPreviewSettingSend}
+	if current != nil && current.Preview != nil {
+		update.Preview = *current.Preview
+	}
+	if in.Preview != "" {
+		update.Preview = lclient.LogStreamPreviewSetting(in.Preview)
+	}
+
+	endpoint := in.Endpoint
+	if endpoint == "" && current != nil && current.Endpoint != nil {
+		endpoint = *current.Endpoint
+	}
+	if endpoint == "" {
+		return update, errors.New("no log stream is configured for this workspace, set --endpoint")
+	}
+	if _, _, err := logstream.ParseEndpoint(endpoint); err != nil {
+		return update, err
+	}
+	update.Endpoint = &endpoint
+
+	if token != "" {
+		update.Token = pointers.From(token)
+	}
+	return update, nil
+}
+
+func SetLogStream(ctx context.Context, input LogStreamSetInput) (*lclient.OwnerLogStreamSetting, error) {
+	token, err := readLogStreamToken("", input.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+	return setLogStream(ctx, input, token)
+}
+
+func setLogStream(ctx context.Context, input LogStreamSetInput, token string) (*lclient.OwnerLogStreamSetting, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := logstream.NewRepo(c)
+
+	current, err := repo.GetSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log stream: %w", err)
+	}
+
+	update, err := input.ToUpdate(current, token)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := repo.UpdateSettings(ctx, update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update log stream: %w", err)
+	}
+	return settings, nil
+}
+
+// LogStreamSetView loads the current log stream to fill in the form before showing it
+type LogStreamSetView struct {
+	ctx        context.Context
+	input      *LogStreamSetInput
+	cobraCmd   *cobra.Command
+	action     func(string) tea.Cmd
+	formAction *tui.FormWithAction[string]
+}
+
+func NewLogStreamSetView(ctx context.Context, input *LogStreamSetInput, cobraCmd *cobra.Command, action func(string) tea.Cmd) *LogStreamSetView {
+	return &LogStreamSetView{
+		ctx:      ctx,
+		input:    input,
+		cobraCmd: cobraCmd,
+		action:   action,
+	}
+}
+
+func (v *LogStreamSetView) Init() tea.Cmd {
+	return command.LoadCmd(v.ctx, LoadLogStreamSettings, LogStreamSettingsInput{}).Unwrap()
+}
+
+func (v *LogStreamSetView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tui.LoadDataMsg[*lclient.OwnerLogStreamSetting]); ok {
+		v.fillFromSettings(msg.Data)
+		v.formAction = v.newForm()
+		return v, v.formAction.Init()
+	}
+
+	if v.formAction == nil {
+		return v, nil
+	}
+	_, cmd := v.formAction.Update(msg)
+	return v, cmd
+}
+
+func (v *LogStreamSetView) View() string {
+	if v.formAction == nil {
+		return "Loading..."
+	}
+	return v.formAction.View()
+}
+
+func (v *LogStreamSetView) fillFromSettings(settings *lclient.OwnerLogStreamSetting) {
+	if settings == nil {
+		return
+	}
+	if v.input.Endpoint == "" && settings.Endpoint != nil {
+		v.input.Endpoint = *settings.Endpoint
+	}
+	if v.input.Preview == "" && settings.Preview != nil {
+		v.input.Preview = string(*settings.Preview)
+	}
+}
+
+func (v *LogStreamSetView) newForm() *tui.FormWithAction[string] {
+	fields, values := command.HuhFormFields(v.cobraCmd, v.input)
+
+	var token string
+	fields = append(fields, tokenField(&token, "The endpoint token. Leave empty to read it from token-file, or to keep the current token"))
+
+	return tui.NewFormWithAction(
+		tui.NewFormAction(
+			v.action,
+			func() tea.Msg {
+				var setInput LogStreamSetInput
+				err := command.StructFromFormValues(values, &setInput)
+				if err != nil {
+					return tui.ErrorMsg{Err: err}
+				}
+				return command.LoadCmd(v.ctx, func(ctx context.Context, input LogStreamSetInput) (string, error) {
+					t, err := readLogStreamToken(token, input.TokenFile)
+					if err != nil {
+						return "", err
+					}
+					settings, err := setLogStream(ctx, input, t)
+					if err != nil {
+						return "", err
+					}
+					return "Updated workspace log stream\n\n" + logstream.Details(settings), nil
+				}, setInput)()
+			},
+		),
+		huh.NewForm(huh.NewGroup(fields...)),
+	)
+}
+
+type LogStreamRemoveInput struct{}
+
+func RemoveLogStream(ctx context.Context, _ LogStreamRemoveInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := logstream.NewRepo(c).DeleteSettings(ctx); err != nil {
+		return "", fmt.Errorf("failed to remove log stream: %w", err)
+	}
+	return "Removed the workspace log stream", nil
+}
+
+func RequireConfirmationForRemoveLogStream(_ context.Context, _ LogStreamRemoveInput) (string, error) {
+	return "Are you sure you want to remove the workspace log stream? Logs will no longer be sent to it, except from resources that override it with their own endpoint.", nil
+}
+
+type LogStreamRemoveView struct {
+	model *tui.SimpleModel
+}
+
+func NewLogStreamRemoveView(ctx context.Context, input LogStreamRemoveInput) *LogStreamRemoveView {
+	return &LogStreamRemoveView{
+		model: tui.NewSimpleModel(command.WrapInConfirm(
+			command.LoadCmd(ctx, RemoveLogStream, input),
+			func() (string, error) { return RequireConfirmationForRemoveLogStream(ctx, input) },
+		)),
+	}
+}
+
+func (v *LogStreamRemoveView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *LogStreamRemoveView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *LogStreamRemoveView) View() string {
+	return v.model.View()
+}
+
+type LogStreamOverrideInput struct {
+	// ResourceIDs is set from all args so several resources can be updated at once
+	ResourceIDs []string
+	Drop        bool   `cli:"drop"`
+	Endpoint    string `cli:"endpoint"`
+	TokenFile   string `cli:"token-file"`
+	Default     bool   `cli:"default"`
+}
+
+// ToUpdate returns the new override, or nil if the resources should go back to the workspace log stream
+func (in LogStreamOverrideInput) ToUpdate(token string) (*lclient.LogStreamResourceUpdate, error) {
+	set := 0
+	for _, isSet := range []bool{in.Drop, in.Endpoint != "", in.Default} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, errors.New("set exactly one of --drop, --endpoint or --default")
+	}
+	if token != "" && in.Endpoint == "" {
+		return nil, errors.New("--token-file can only be used with --endpoint")
+	}
+
+	switch {
+	case in.Default:
+		return nil, nil
+	case in.Drop:
+		return &lclient.LogStreamResourceUpdate{Setting: lclient.LogStreamSettingDrop}, nil
+	}
+
+	if _, _, err := logstream.ParseEndpoint(in.Endpoint); err != nil {
+		return nil, err
+	}
+	update := &lclient.LogStreamResourceUpdate{
+		Setting:  lclient.LogStreamSettingSend,
+		Endpoint: pointers.From(in.Endpoint),
+	}
+	if token != "" {
+		update.Token = pointers.From(token)
+	}
+	return update, nil
+}
+
+// OverrideResourceLogStreams updates the override of each resource. It stops at the first failure, the error lists
+// the resources that were already updated. Resources that go back to the workspace log stream are returned without
+// a setting.
+func OverrideResourceLogStreams(ctx context.Context, input LogStreamOverrideInput) ([]*lclient.ResourceLogStreamSetting, error) {
+	if len(input.ResourceIDs) == 0 {
+		return nil, errors.New("at least one resource ID is required")
+	}
+
+	token, err := readLogStreamToken("", input.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := input.ToUpdate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := logstream.NewRepo(c)
+
+	overrides := make([]*lclient.ResourceLogStreamSetting, 0, len(input.ResourceIDs))
+	for _, id := range input.ResourceIDs {
+		override := &lclient.ResourceLogStreamSetting{ResourceId: pointers.From(id)}
+		if update == nil {
+			err = repo.DeleteOverride(ctx, id)
+		} else {
+			override, err = repo.UpdateOverride(ctx, id, *update)
+		}
+		if err != nil {
+			if len(overrides) > 0 {
+				return nil, fmt.Errorf("failed to update the log stream of %s after updating %s: %w", id, strings.Join(input.ResourceIDs[:len(overrides)], ", "), err)
+			}
+			return nil, fmt.Errorf("failed to update the log stream of %s: %w", id, err)
+		}
+		if override == nil {
+			override = &lclient.ResourceLogStreamSetting{Setting: &update.Setting, Endpoint: update.Endpoint}
+		}
+		if override.ResourceId == nil {
+			override.ResourceId = pointers.From(id)
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
+
+func LogStreamOverrideMessage(overrides []*lclient.ResourceLogStreamSetting) string {
+	lines := make([]string, 0, len(overrides))
+	for _, o := range overrides {
+		lines = append(lines, logstream.OverrideMessage(*o.ResourceId, o))
+	}
+	return strings.Join(lines, "\n")
+}
+
+type LogStreamOverrideView struct {
+	model *tui.SimpleModel
+}
+
+func NewLogStreamOverrideView(ctx context.Context, input LogStreamOverrideInput) *LogStreamOverrideView {
+	return &LogStreamOverrideView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input LogStreamOverrideInput) (string, error) {
+			overrides, err := OverrideResourceLogStreams(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return LogStreamOverrideMessage(overrides), nil
+		}, input)),
+	}
+}
+
+func (v *LogStreamOverrideView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *LogStreamOverrideView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *LogStreamOverrideView) View() string {
+	return v.model.View()
+}
@@ -0,0 +1,24 @@
+package views_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+func TestLogStreamOverrideToUpdate(t *testing.T) {
+	update, err := views.LogStreamOverrideInput{Endpoint: "logs.example.com:6514"}.ToUpdate("secret")
+	require.NoError(t, err)
+	require.NotNil(t, update.Token)
+	assert.Equal(t, "secret", *update.Token)
+
+	update, err = views.LogStreamOverrideInput{Endpoint: "logs.example.com:6514"}.ToUpdate("")
+	require.NoError(t, err)
+	assert.Nil(t, update.Token)
+
+	_, err = views.LogStreamOverrideInput{Drop: true}.ToUpdate("secret")
+	assert.ErrorContains(t, err, "--token-file can only be used with --endpoint")
+}
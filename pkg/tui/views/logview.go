@@ -56,12 +56,22 @@ type LogInput struct {
 	Path       []string `cli:"path"`
 
 	Limit     int    `cli:"limit"`
+	All       bool   `cli:"all"`
 	Direction string `cli:"direction"`
 	Tail      bool   `cli:"tail"`
 
+	Fields     []string            `cli:"fields"`
+	Where      command.StringArray `cli:"where"`
+	ExpandJSON bool                `cli:"expand-json"`
+
 	ListResourceInput ListResourceInput
 }
 
+// Pipeline returns the processing of JSON log messages, or nil if none was requested
+func (l LogInput) Pipeline() (*logs.Pipeline, error) {
+	return logs.NewPipeline(l.Fields, l.Where, l.ExpandJSON)
+}
+
 func (l LogInput) ToParam() (*client.ListLogsParams, error) {
 	ownerID, err := config.WorkspaceID()
 	if err != nil {
@@ -122,6 +132,14 @@ type LogsView struct {
 
 	onFilter    func() tea.Cmd
 	isSearching bool
+
+	loadFilterValues []tea.Cmd
+	filterValues     map[string][]string
+}
+
+type filterValuesMsg struct {
+	filter string
+	values []string
 }
 
 type FooterModel struct {
@@ -169,19 +187,98 @@ func LoadLogData(ctx context.Context, in LogInput) (*tui.LogResult, error) {
 		return nil, fmt.Errorf("error converting input to params: %v", err)
 	}
 
+	pipeline, err := in.Pipeline()
+	if err != nil {
+		return nil, err
+	}
+
 	if in.Tail {
 		logChan, err := logRepo.TailLogs(ctx, params)
 		if err != nil {
 			return nil, fmt.Errorf("error tailing logs: %v", err)
 		}
-		return &tui.LogResult{Logs: &client.Logs200Response{}, LogChannel: logChan}, nil
+		return &tui.LogResult{
+			Logs:       &client.Logs200Response{},
+			LogChannel: pipeline.Tail(ctx, logChan),
+			ShowSource: len(in.ResourceIDs) > 1,
+		}, nil
 	}
 
-	logs, err := logRepo.ListLogs(ctx, params)
+	result, err := listLogs(ctx, logRepo, params, in.Limit)
 	if err != nil {
 		return nil, fmt.Errorf("error listing logs: %v", err)
 	}
-	return &tui.LogResult{Logs: logs, LogChannel: nil}, nil
+	loadOlder := loadOlderLogs(ctx, logRepo, params, pipeline, result)
+	result.Logs = pipeline.Apply(result.Logs)
+	return &tui.LogResult{
+		Logs:       result,
+		LoadOlder:  loadOlder,
+		ShowSource: len(in.ResourceIDs) > 1,
+	}, nil
+}
+
+// listLogs fetches up to limit logs, following pagination if limit is more than a page
+func listLogs(ctx context.Context, logRepo *logs.LogRepo, params *client.ListLogsParams, limit int) (*client.Logs200Response, error) {
+	if limit <= logs.MaxPageSize {
+		return logRepo.ListLogs(ctx, params)
+	}
+
+	result := &client.Logs200Response{}
+	err := logRepo.ListLogPages(ctx, params, limit, func(page *client.Logs200Response) error {
+		result.Logs = append(result.Logs, page.Logs...)
+		result.HasMore = page.HasMore
+		result.NextStartTime = page.NextStartTime
+		result.NextEndTime = page.NextEndTime
+		return nil
+	})
+	return result, err
+}
+
+// loadOlderLogs returns a function to fetch the page of logs before page, or nil if there are none. Only backward
+// queries page towards older logs.
+func loadOlderLogs(ctx context.Context, logRepo *logs.LogRepo, params *client.ListLogsParams, pipeline *logs.Pipeline, page *client.Logs200Response) func() (*tui.LogResult, error) {
+	if !page.HasMore || params.Direction == nil || *params.Direction != lclient.Backward {
+		return nil
+	}
+
+	return func() (*tui.LogResult, error) {
+		nextParams := logs.NextPageParams(params, page)
+		nextParams.Limit = pointers.From(logs.MaxPageSize)
+
+		older, err := logRepo.ListLogs(ctx, nextParams)
+		if err != nil {
+			return nil, fmt.Errorf("error listing logs: %v", err)
+		}
+		loadOlder := loadOlderLogs(ctx, logRepo, nextParams, pipeline, older)
+		older.Logs = pipeline.Apply(older.Logs)
+		return &tui.LogResult{Logs: older, LoadOlder: loadOlder}, nil
+	}
+}
+
+// LoadFilterValues returns the values seen for the logs filter in the input's resources and time range
+func LoadFilterValues(ctx context.Context, in LogInput, filter string) ([]string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := in.ToParam()
+	if err != nil {
+		return nil, fmt.Errorf("error converting input to params: %v", err)
+	}
+
+	return logs.NewLogRepo(c).FilterValues(ctx, params, filter)
+}
+
+func loadFilterValuesCmd(ctx context.Context, in LogInput, filter string) tea.Cmd {
+	return func() tea.Msg {
+		values, err := LoadFilterValues(ctx, in, filter)
+		if err != nil {
+			// suggestions are optional, so the filters still work as free text
+			return nil
+		}
+		return filterValuesMsg{filter: filter, values: values}
+	}
 }
 
 type tabDefinition struct {
@@ -195,6 +292,8 @@ func tabModel(fields []huh.Field) *tui.TabModel {
 		{TabName: "Time", FieldNames: []string{"start", "end"}},
 		{TabName: "Request", FieldNames: []string{"host", "status-code", "method", "path"}},
 		{TabName: "Query", FieldNames: []string{"limit", "direction", "tail"}},
+		{TabName: "JSON", FieldNames: []string{"fields", "where", "expand-json"}},
+		{TabName: "Presets", FieldNames: []string{"preset", "save"}},
 	}
 
 	var tabs []*tui.Tab
@@ -251,7 +350,23 @@ func NewLogsView(
 		// Create log filter form
 		fields, result := command.HuhFormFields(logsCmd, &input)
 
-		tabs := tabModel(fields)
+		view.filterValues = map[string][]string{}
+		for _, field := range fields {
+			filter := field.GetKey()
+			inputField, ok := field.(*huh.Input)
+			if !ok || !slices.Contains(logs.SuggestedFilters, filter) {
+				continue
+			}
+
+			value := result[filter]
+			inputField.SuggestionsFunc(func() []string {
+				return command.ListSuggestion(value.String(), view.filterValues[filter])
+			}, value)
+			view.loadFilterValues = append(view.loadFilterValues, loadFilterValuesCmd(ctx, input, filter))
+		}
+
+		presets := &presetForm{}
+		tabs := tabModel(append(fields, presets.fields()...))
 		view.onFilter = func() tea.Cmd {
 			var logInput LogInput
 			err := command.StructFromFormValues(result, &logInput)
@@ -259,6 +374,11 @@ func NewLogsView(
 				return func() tea.Msg { return tui.ErrorMsg{Err: fmt.Errorf("failed to parse form values: %w", err)} }
 			}
 
+			logInput, err = presets.apply(logInput)
+			if err != nil {
+				return func() tea.Msg { return tui.ErrorMsg{Err: err} }
+			}
+
 			return interactiveLogsCommand(ctx, logInput, "") // we don't need a breadcrumb for the filter window
 		}
 		view.tabModel = tabs
@@ -278,7 +398,7 @@ func (v *LogsView) Init() tea.Cmd {
 	if v.resourceTable != nil {
 		return v.resourceTable.Init()
 	}
-	return v.layout.Init()
+	return tea.Batch(append(v.loadFilterValues, v.layout.Init())...)
 }
 
 func (v *LogsView) filterHelp() string {
@@ -305,7 +425,14 @@ func (v *LogsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	switch msg := msg.(type) {
+	case filterValuesMsg:
+		v.filterValues[msg.filter] = msg.values
+		return v, nil
 	case tea.KeyMsg:
+		// keys edit the query while searching the loaded logs
+		if !v.isSearching && v.logModel.IsSearching() {
+			break
+		}
 		switch msg.Type {
 		case tea.KeyEnter:
 			return v, v.onFilter()
@@ -3,6 +3,7 @@ package views_test
 import (
 	"bytes"
 	"context"
+	"path/filepath"
 	"slices"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/renderinc/cli/cmd"
 	"github.com/renderinc/cli/pkg/client"
 	lclient "github.com/renderinc/cli/pkg/client/logs"
+	"github.com/renderinc/cli/pkg/logs"
 	"github.com/renderinc/cli/pkg/tui"
 	"github.com/renderinc/cli/pkg/tui/testhelper"
 	"github.com/renderinc/cli/pkg/tui/views"
@@ -106,4 +108,61 @@ func TestLogView(t *testing.T) {
 		err := tm.Quit()
 		require.NoError(t, err)
 	})
+
+	t.Run("loads a preset", func(t *testing.T) {
+		t.Setenv("RENDER_CLI_CONFIG_PATH", filepath.Join(t.TempDir(), "cli.yaml"))
+		require.NoError(t, logs.SavePreset("errors", []string{"--level=error"}))
+
+		ctx := context.Background()
+
+		input := views.LogInput{
+			ResourceIDs: []string{"foo"},
+		}
+
+		var interactiveInput views.LogInput
+		interactiveLogsCommand := func(ctx context.Context, input views.LogInput, breadcrumb string) tea.Cmd {
+			interactiveInput = input
+			return nil
+		}
+
+		m := views.NewLogsView(ctx, cmd.LogsCmd, interactiveLogsCommand, input, loadFunc)
+		tm := teatest.NewTestModel(t, testhelper.Stackify(m))
+
+		tm.Send(tea.WindowSizeMsg{Width: 80, Height: 80})
+
+		tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+		for range 5 {
+			tm.Send(tea.KeyMsg{Type: tea.KeyShiftRight})
+		}
+
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("errors"))
+		}, teatest.WithCheckInterval(time.Millisecond*10), teatest.WithDuration(time.Second*3))
+
+		// Pick the preset after none
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+		tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+		require.Eventually(t, func() bool {
+			return slices.Equal(interactiveInput.Level, []string{"error"}) && slices.Equal(interactiveInput.ResourceIDs, []string{"foo"})
+		}, time.Second*3, time.Millisecond*10)
+
+		err := tm.Quit()
+		require.NoError(t, err)
+	})
+}
+
+func TestLogPresetWhere(t *testing.T) {
+	t.Setenv("RENDER_CLI_CONFIG_PATH", filepath.Join(t.TempDir(), "cli.yaml"))
+
+	input := views.LogInput{
+		ResourceIDs: []string{"srv-1"},
+		Where:       []string{"status>=500", "msg=~a{1,3}"},
+	}
+	require.NoError(t, views.SaveLogPreset("errors", input))
+
+	preset, err := views.LogInputFromPreset("errors")
+	require.NoError(t, err)
+	require.Equal(t, input.ResourceIDs, preset.ResourceIDs)
+	require.Equal(t, input.Where, preset.Where)
 }
@@ -0,0 +1,131 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientmaintenance "github.com/renderinc/cli/pkg/client/maintenance"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/maintenance"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/resource"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type MaintenanceListInput struct {
+	State       []string `cli:"state"`
+	ResourceIDs []string `cli:"resources"`
+}
+
+func (m MaintenanceListInput) ToParams() *client.ListMaintenanceParams {
+	params := &client.ListMaintenanceParams{}
+	if len(m.State) > 0 {
+		states := make([]clientmaintenance.MaintenanceState, 0, len(m.State))
+		for _, s := range m.State {
+			states = append(states, clientmaintenance.MaintenanceState(s))
+		}
+		params.State = &states
+	}
+	if len(m.ResourceIDs) > 0 {
+		params.ResourceId = pointers.From(m.ResourceIDs)
+	}
+	return params
+}
+
+func LoadMaintenance(ctx context.Context, in MaintenanceListInput) ([]*maintenance.Model, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	runs, err := maintenance.NewRepo(c).ListMaintenance(ctx, in.ToParams())
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	resourceService, err := resource.NewDefaultResourceService()
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := resourceService.ListResources(ctx, resource.ResourceParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return maintenance.WithResources(runs, resources), nil
+}
+
+type MaintenanceList struct {
+	table *tui.Table[*maintenance.Model]
+}
+
+func NewMaintenanceList(ctx context.Context, input MaintenanceListInput, selectRun OnSelectFuncT[*maintenance.Model], opts ...tui.TableOption[*maintenance.Model]) *MaintenanceList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Resource", "Resource", 3).WithFiltered(true),
+		btable.NewColumn("Type", "Type", 18).WithFiltered(true),
+		btable.NewFlexColumn("Maintenance", "Maintenance", 2).WithFiltered(true),
+		btable.NewColumn("State", "State", 18).WithFiltered(true),
+		btable.NewColumn("Scheduled", "Scheduled", 25),
+		btable.NewColumn("Deadline", "Deadline", 25),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(m *maintenance.Model) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"ID":          m.Run.Id,
+			"Resource":    maintenance.ResourceLabel(m),
+			"Type":        m.ResourceType,
+			"Maintenance": m.Run.Type,
+			"State":       string(m.Run.State),
+			"Scheduled":   m.Run.ScheduledAt.Format(time.RFC3339),
+			"Deadline":    pointers.TimeValue(m.Run.PendingMaintenanceBy),
+			"maintenance": m, // this will be hidden in the UI, but will be used to get the run when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		m, ok := rows[0].Data["maintenance"].(*maintenance.Model)
+		if !ok {
+			return nil
+		}
+
+		return selectRun(ctx, m)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadMaintenance, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &MaintenanceList{
+		table: t,
+	}
+}
+
+func (ml *MaintenanceList) Init() tea.Cmd {
+	return ml.table.Init()
+}
+
+func (ml *MaintenanceList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return ml.table.Update(msg)
+}
+
+func (ml *MaintenanceList) View() string {
+	return ml.table.View()
+}
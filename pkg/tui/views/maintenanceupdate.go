@@ -0,0 +1,147 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/maintenance"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type MaintenanceRescheduleInput struct {
+	RunID string `cli:"arg:0"`
+	At    string `cli:"at"`
+}
+
+func RescheduleMaintenance(ctx context.Context, input MaintenanceRescheduleInput) (string, error) {
+	now := time.Now()
+	at, err := maintenance.ParseScheduledAt(now, input.At)
+	if err != nil {
+		return "", err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := maintenance.NewRepo(c)
+
+	run, err := repo.GetMaintenance(ctx, input.RunID)
+	if err != nil {
+		return "", err
+	}
+	if err := maintenance.ValidateScheduledAt(now, run, at); err != nil {
+		return "", err
+	}
+
+	if err := repo.RescheduleMaintenance(ctx, input.RunID, at); err != nil {
+		return "", fmt.Errorf("failed to reschedule maintenance: %w", err)
+	}
+
+	return fmt.Sprintf("Rescheduled %s maintenance %s from %s to %s", run.Type, run.Id,
+		run.ScheduledAt.Local().Format(time.RFC1123), at.Local().Format(time.RFC1123)), nil
+}
+
+type MaintenanceRescheduleView struct {
+	formAction *tui.FormWithAction[string]
+}
+
+func NewMaintenanceRescheduleView(ctx context.Context, input *MaintenanceRescheduleInput, cobraCmd *cobra.Command, action func(string) tea.Cmd) *MaintenanceRescheduleView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &MaintenanceRescheduleView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					var rescheduleInput MaintenanceRescheduleInput
+					err := command.StructFromFormValues(values, &rescheduleInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, RescheduleMaintenance, rescheduleInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *MaintenanceRescheduleView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *MaintenanceRescheduleView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *MaintenanceRescheduleView) View() string {
+	return v.formAction.View()
+}
+
+type MaintenanceTriggerInput struct {
+	RunID string `cli:"arg:0"`
+}
+
+func TriggerMaintenance(ctx context.Context, input MaintenanceTriggerInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := maintenance.NewRepo(c).TriggerMaintenance(ctx, input.RunID); err != nil {
+		return "", fmt.Errorf("failed to trigger maintenance: %w", err)
+	}
+
+	return fmt.Sprintf("Started maintenance %s", input.RunID), nil
+}
+
+func RequireConfirmationForTriggerMaintenance(ctx context.Context, input MaintenanceTriggerInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	run, err := maintenance.NewRepo(c).GetMaintenance(ctx, input.RunID)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Start %s maintenance on %s now instead of %s? The resource may be briefly unavailable.",
+		run.Type, run.ResourceId, run.ScheduledAt.Local().Format(time.RFC1123)), nil
+}
+
+type MaintenanceTriggerView struct {
+	model *tui.SimpleModel
+}
+
+func NewMaintenanceTriggerView(ctx context.Context, input MaintenanceTriggerInput) *MaintenanceTriggerView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, TriggerMaintenance, input),
+		func() (string, error) { return RequireConfirmationForTriggerMaintenance(ctx, input) },
+	))
+
+	return &MaintenanceTriggerView{
+		model: model,
+	}
+}
+
+func (v *MaintenanceTriggerView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *MaintenanceTriggerView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *MaintenanceTriggerView) View() string {
+	return v.model.View()
+}
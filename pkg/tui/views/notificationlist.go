@@ -0,0 +1,174 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/service"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type NotificationSettingsInput struct{}
+
+func LoadNotificationSettings(ctx context.Context, _ NotificationSettingsInput) (*clientnotifications.NotificationSetting, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return notification.NewRepo(c).GetSettings(ctx)
+}
+
+type NotificationSettingsView struct {
+	model *tui.SimpleModel
+}
+
+func NewNotificationSettingsView(ctx context.Context, input NotificationSettingsInput) *NotificationSettingsView {
+	return &NotificationSettingsView{
+		model: tui.NewSimpleModel(command.LoadCmd(ctx, func(ctx context.Context, input NotificationSettingsInput) (string, error) {
+			settings, err := LoadNotificationSettings(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return notification.Details(settings), nil
+		}, input)),
+	}
+}
+
+func (v *NotificationSettingsView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *NotificationSettingsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *NotificationSettingsView) View() string {
+	return v.model.View()
+}
+
+type NotificationListInput struct {
+	EnvironmentIDs []string `cli:"environment-ids"`
+	PreviewsOnly   bool     `cli:"previews-only"`
+}
+
+// LoadServiceNotifications returns the effective notification behavior of every service in the workspace,
+// including preview services
+func LoadServiceNotifications(ctx context.Context, in NotificationListInput) ([]*notification.ServiceNotifications, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := notification.NewRepo(c)
+
+	var settings *clientnotifications.NotificationSetting
+	var overrides []*clientnotifications.NotificationOverride
+	var services []*service.Model
+
+	wg, gctx := errgroup.WithContext(ctx)
+	wg.Go(func() error {
+		var err error
+		settings, err = repo.GetSettings(gctx)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		overrides, err = repo.ListOverrides(gctx, &client.ListNotificationOverridesParams{})
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		services, err = listServices(gctx, ServiceInput{EnvironmentIDs: in.EnvironmentIDs, IncludePreviews: true})
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := notification.ForServices(settings, overrides, services)
+	if !in.PreviewsOnly {
+		return result, nil
+	}
+
+	previews := make([]*notification.ServiceNotifications, 0, len(result))
+	for _, s := range result {
+		if s.IsPreview {
+			previews = append(previews, s)
+		}
+	}
+	return previews, nil
+}
+
+type NotificationList struct {
+	table *tui.Table[*notification.ServiceNotifications]
+}
+
+func NewNotificationList(ctx context.Context, input NotificationListInput, selectService OnSelectFuncT[*notification.ServiceNotifications], opts ...tui.TableOption[*notification.ServiceNotifications]) *NotificationList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Service", "Service", 3).WithFiltered(true),
+		btable.NewColumn("Type", "Type", 18).WithFiltered(true),
+		btable.NewColumn("Preview", "Preview", 8).WithFiltered(true),
+		btable.NewColumn("Notify On", "Notify On", 20).WithFiltered(true),
+		btable.NewColumn("Previews", "Preview Notifications", 22).WithFiltered(true),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(s *notification.ServiceNotifications) btable.Row {
+		row := notification.Row(s)
+		return btable.NewRow(btable.RowData{
+			"ID":           s.ServiceID,
+			"Service":      row[0],
+			"Type":         row[1],
+			"Preview":      row[2],
+			"Notify On":    row[3],
+			"Previews":     row[4],
+			"notification": s, // this will be hidden in the UI, but will be used to get the service when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		s, ok := rows[0].Data["notification"].(*notification.ServiceNotifications)
+		if !ok {
+			return nil
+		}
+
+		return selectService(ctx, s)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadServiceNotifications, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &NotificationList{
+		table: t,
+	}
+}
+
+func (nl *NotificationList) Init() tea.Cmd {
+	return nl.table.Init()
+}
+
+func (nl *NotificationList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return nl.table.Update(msg)
+}
+
+func (nl *NotificationList) View() string {
+	return nl.table.View()
+}
@@ -0,0 +1,275 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	clientnotifications "github.com/renderinc/cli/pkg/client/notifications"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/notification"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type NotificationSetInput struct {
+	Notify   string `cli:"notify"`
+	Previews string `cli:"previews"`
+	Email    string `cli:"email"`
+}
+
+// ToPatch returns the changes to the workspace settings. Empty fields are left unchanged.
+func (in NotificationSetInput) ToPatch() (clientnotifications.NotificationSettingPATCH, error) {
+	var patch clientnotifications.NotificationSettingPATCH
+	var err error
+
+	if in.Notify != "" {
+		patch.NotificationsToSend = pointers.From(clientnotifications.NotifySettingV2(in.Notify))
+	}
+	if patch.PreviewNotificationsEnabled, err = notification.ParseOnOff(in.Previews); err != nil {
+		return patch, fmt.Errorf("invalid previews: %w", err)
+	}
+	if patch.EmailEnabled, err = notification.ParseOnOff(in.Email); err != nil {
+		return patch, fmt.Errorf("invalid email: %w", err)
+	}
+
+	if patch.NotificationsToSend == nil && patch.PreviewNotificationsEnabled == nil && patch.EmailEnabled == nil {
+		return patch, errors.New("nothing to update, set at least one of --notify, --previews or --email")
+	}
+	return patch, nil
+}
+
+func SetNotificationSettings(ctx context.Context, input NotificationSetInput) (*clientnotifications.NotificationSetting, error) {
+	patch, err := input.ToPatch()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	settings, err := notification.NewRepo(c).UpdateSettings(ctx, patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification settings: %w", err)
+	}
+	return settings, nil
+}
+
+// NotificationSetView loads the current settings to fill in the form before showing it
+type NotificationSetView struct {
+	ctx        context.Context
+	input      *NotificationSetInput
+	cobraCmd   *cobra.Command
+	action     func(string) tea.Cmd
+	formAction *tui.FormWithAction[string]
+}
+
+func NewNotificationSetView(ctx context.Context, input *NotificationSetInput, cobraCmd *cobra.Command, action func(string) tea.Cmd) *NotificationSetView {
+	return &NotificationSetView{
+		ctx:      ctx,
+		input:    input,
+		cobraCmd: cobraCmd,
+		action:   action,
+	}
+}
+
+func (v *NotificationSetView) Init() tea.Cmd {
+	return command.LoadCmd(v.ctx, LoadNotificationSettings, NotificationSettingsInput{}).Unwrap()
+}
+
+func (v *NotificationSetView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tui.LoadDataMsg[*clientnotifications.NotificationSetting]); ok {
+		v.fillFromSettings(msg.Data)
+		v.formAction = v.newForm()
+		return v, v.formAction.Init()
+	}
+
+	if v.formAction == nil {
+		return v, nil
+	}
+	_, cmd := v.formAction.Update(msg)
+	return v, cmd
+}
+
+func (v *NotificationSetView) View() string {
+	if v.formAction == nil {
+		return "Loading..."
+	}
+	return v.formAction.View()
+}
+
+func (v *NotificationSetView) fillFromSettings(settings *clientnotifications.NotificationSetting) {
+	if v.input.Notify == "" {
+		v.input.Notify = string(settings.NotificationsToSend)
+	}
+	if v.input.Previews == "" {
+		v.input.Previews = notification.OnOff(settings.PreviewNotificationsEnabled)
+	}
+	if v.input.Email == "" {
+		v.input.Email = notification.OnOff(settings.EmailEnabled)
+	}
+}
+
+func (v *NotificationSetView) newForm() *tui.FormWithAction[string] {
+	fields, values := command.HuhFormFields(v.cobraCmd, v.input)
+
+	return tui.NewFormWithAction(
+		tui.NewFormAction(
+			v.action,
+			func() tea.Msg {
+				var setInput NotificationSetInput
+				err := command.StructFromFormValues(values, &setInput)
+				if err != nil {
+					return tui.ErrorMsg{Err: err}
+				}
+				return command.LoadCmd(v.ctx, func(ctx context.Context, input NotificationSetInput) (string, error) {
+					settings, err := SetNotificationSettings(ctx, input)
+					if err != nil {
+						return "", err
+					}
+					return "Updated workspace notification settings\n\n" + notification.Details(settings), nil
+				}, setInput)()
+			},
+		),
+		huh.NewForm(huh.NewGroup(fields...)),
+	)
+}
+
+type NotificationOverrideInput struct {
+	// ServiceIDs is set from all args so several services can be updated at once
+	ServiceIDs []string
+	Notify     string `cli:"notify"`
+	Previews   string `cli:"previews"`
+}
+
+// ToPatch returns the changes to the service overrides. Empty fields are left unchanged.
+func (in NotificationOverrideInput) ToPatch() (clientnotifications.NotificationServiceOverridePATCH, error) {
+	var patch clientnotifications.NotificationServiceOverridePATCH
+
+	if in.Notify != "" {
+		patch.NotificationsToSend = pointers.From(clientnotifications.NotifyOverride(in.Notify))
+	}
+	if in.Previews != "" {
+		previews, err := notification.ToPreviewOverride(in.Previews)
+		if err != nil {
+			return patch, fmt.Errorf("invalid previews: %w", err)
+		}
+		patch.PreviewNotificationsEnabled = &previews
+	}
+
+	if patch.NotificationsToSend == nil && patch.PreviewNotificationsEnabled == nil {
+		return patch, errors.New("nothing to update, set at least one of --notify or --previews")
+	}
+	return patch, nil
+}
+
+// OverrideServiceNotifications updates the overrides of each service. It stops at the first failure, the
+// error lists the services that were already updated.
+func OverrideServiceNotifications(ctx context.Context, input NotificationOverrideInput) ([]*clientnotifications.NotificationServiceOverride, error) {
+	if len(input.ServiceIDs) == 0 {
+		return nil, errors.New("at least one service ID is required")
+	}
+
+	patch, err := input.ToPatch()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := notification.NewRepo(c)
+
+	overrides := make([]*clientnotifications.NotificationServiceOverride, 0, len(input.ServiceIDs))
+	for _, id := range input.ServiceIDs {
+		override, err := repo.UpdateServiceOverride(ctx, id, patch)
+		if err != nil {
+			if len(overrides) > 0 {
+				return nil, fmt.Errorf("failed to update notifications for %s after updating %s: %w", id, joinOverrideIDs(overrides), err)
+			}
+			return nil, fmt.Errorf("failed to update notifications for %s: %w", id, err)
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
+
+func OverrideMessage(overrides []*clientnotifications.NotificationServiceOverride) string {
+	if len(overrides) == 1 {
+		o := overrides[0]
+		return fmt.Sprintf("Updated notifications for %s: notify on %s, preview notifications %s",
+			o.ServiceId, o.NotificationsToSend, previewOverrideLabel(o.PreviewNotificationsEnabled))
+	}
+	return fmt.Sprintf("Updated notifications for %d services: %s", len(overrides), joinOverrideIDs(overrides))
+}
+
+func previewOverrideLabel(p clientnotifications.NotifyPreviewOverride) string {
+	switch p {
+	case clientnotifications.NotifyPreviewOverrideTrue:
+		return notification.On
+	case clientnotifications.NotifyPreviewOverrideFalse:
+		return notification.Off
+	}
+	return string(p)
+}
+
+func joinOverrideIDs(overrides []*clientnotifications.NotificationServiceOverride) string {
+	ids := make([]string, 0, len(overrides))
+	for _, o := range overrides {
+		ids = append(ids, o.ServiceId)
+	}
+	return strings.Join(ids, ", ")
+}
+
+type NotificationOverrideView struct {
+	formAction *tui.FormWithAction[string]
+}
+
+func NewNotificationOverrideView(ctx context.Context, input *NotificationOverrideInput, cobraCmd *cobra.Command, action func(string) tea.Cmd) *NotificationOverrideView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &NotificationOverrideView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					overrideInput := NotificationOverrideInput{ServiceIDs: input.ServiceIDs}
+					err := command.StructFromFormValues(values, &overrideInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, func(ctx context.Context, input NotificationOverrideInput) (string, error) {
+						overrides, err := OverrideServiceNotifications(ctx, input)
+						if err != nil {
+							return "", err
+						}
+						return OverrideMessage(overrides), nil
+					}, overrideInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *NotificationOverrideView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *NotificationOverrideView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *NotificationOverrideView) View() string {
+	return v.formAction.View()
+}
@@ -0,0 +1,223 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/environment"
+	"github.com/renderinc/cli/pkg/pointers"
+	"github.com/renderinc/cli/pkg/project"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type ProjectCreateInput struct {
+	Name         string   `cli:"name"`
+	Environments []string `cli:"environments"`
+}
+
+func (in ProjectCreateInput) ToBody() client.ProjectPOSTInput {
+	environments := in.Environments
+	if len(environments) == 0 {
+		environments = []string{"Production"}
+	}
+
+	body := client.ProjectPOSTInput{Name: in.Name}
+	for _, name := range environments {
+		body.Environments = append(body.Environments, client.ProjectPOSTEnvironmentInput{Name: name})
+	}
+	return body
+}
+
+func CreateProject(ctx context.Context, input ProjectCreateInput) (*client.Project, error) {
+	if input.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	p, err := project.NewRepo(c).CreateProject(ctx, input.ToBody())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+	return p, nil
+}
+
+type ProjectCreateView struct {
+	formAction *tui.FormWithAction[*client.Project]
+}
+
+func NewProjectCreateView(ctx context.Context, input *ProjectCreateInput, cobraCmd *cobra.Command, action func(*client.Project) tea.Cmd) *ProjectCreateView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &ProjectCreateView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					var createInput ProjectCreateInput
+					err := command.StructFromFormValues(values, &createInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, CreateProject, createInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *ProjectCreateView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *ProjectCreateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *ProjectCreateView) View() string {
+	return v.formAction.View()
+}
+
+type ProjectRenameInput struct {
+	ProjectID string `cli:"arg:0"`
+	Name      string `cli:"name"`
+}
+
+func RenameProject(ctx context.Context, input ProjectRenameInput) (*client.Project, error) {
+	if input.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	p, err := project.NewRepo(c).UpdateProject(ctx, input.ProjectID, client.ProjectPATCHInput{
+		Name: pointers.From(input.Name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename project: %w", err)
+	}
+	return p, nil
+}
+
+type ProjectRenameView struct {
+	formAction *tui.FormWithAction[*client.Project]
+}
+
+func NewProjectRenameView(ctx context.Context, input *ProjectRenameInput, cobraCmd *cobra.Command, action func(*client.Project) tea.Cmd) *ProjectRenameView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &ProjectRenameView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					renameInput := ProjectRenameInput{ProjectID: input.ProjectID}
+					err := command.StructFromFormValues(values, &renameInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, RenameProject, renameInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *ProjectRenameView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *ProjectRenameView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *ProjectRenameView) View() string {
+	return v.formAction.View()
+}
+
+type ProjectDeleteInput struct {
+	ProjectID string `cli:"arg:0"`
+}
+
+func DeleteProject(ctx context.Context, input ProjectDeleteInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := project.NewRepo(c).DeleteProject(ctx, input.ProjectID); err != nil {
+		return "", fmt.Errorf("failed to delete project: %w", err)
+	}
+	return fmt.Sprintf("Project %s successfully deleted", input.ProjectID), nil
+}
+
+func RequireConfirmationForDeleteProject(ctx context.Context, input ProjectDeleteInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	p, err := project.NewRepo(c).GetProject(ctx, input.ProjectID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+
+	envs, err := environment.NewRepo(c).ListEnvironments(ctx, &client.ListEnvironmentsParams{
+		ProjectId: []string{input.ProjectID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	var resources int
+	for _, env := range envs {
+		resources += environment.ResourceCount(env)
+	}
+
+	return fmt.Sprintf(
+		"Are you sure you want to delete project %s? It has %d environments with %d resources.",
+		p.Name, len(envs), resources,
+	), nil
+}
+
+type ProjectDeleteView struct {
+	model *tui.SimpleModel
+}
+
+func NewProjectDeleteView(ctx context.Context, input ProjectDeleteInput) *ProjectDeleteView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, DeleteProject, input),
+		func() (string, error) { return RequireConfirmationForDeleteProject(ctx, input) },
+	))
+
+	return &ProjectDeleteView{
+		model: model,
+	}
+}
+
+func (v *ProjectDeleteView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *ProjectDeleteView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *ProjectDeleteView) View() string {
+	return v.model.View()
+}
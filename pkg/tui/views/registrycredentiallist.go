@@ -0,0 +1,227 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/service"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type RegistryCredentialListInput struct {
+	Registries []string `cli:"registry"`
+}
+
+func (r RegistryCredentialListInput) ToParams() *client.ListRegistryCredentialsParams {
+	params := &client.ListRegistryCredentialsParams{}
+	if len(r.Registries) > 0 {
+		registries := make([]client.RegistryCredentialRegistry, 0, len(r.Registries))
+		for _, reg := range r.Registries {
+			registries = append(registries, client.RegistryCredentialRegistry(reg))
+		}
+		params.Type = &registries
+	}
+	return params
+}
+
+func LoadRegistryCredentials(ctx context.Context, in RegistryCredentialListInput) ([]*client.RegistryCredential, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return registrycredential.NewRepo(c).ListRegistryCredentials(ctx, in.ToParams())
+}
+
+type RegistryCredentialList struct {
+	table *tui.Table[*client.RegistryCredential]
+}
+
+func NewRegistryCredentialList(ctx context.Context, input RegistryCredentialListInput, selectCredential OnSelectFuncT[*client.RegistryCredential], opts ...tui.TableOption[*client.RegistryCredential]) *RegistryCredentialList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 3).WithFiltered(true),
+		btable.NewColumn("Registry", "Registry", 16).WithFiltered(true),
+		btable.NewFlexColumn("Username", "Username", 2).WithFiltered(true),
+		btable.NewColumn("Updated", "Updated", 25),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(cred *client.RegistryCredential) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"ID":         cred.Id,
+			"Name":       cred.Name,
+			"Registry":   string(cred.Registry),
+			"Username":   cred.Username,
+			"Updated":    cred.UpdatedAt.Format(time.RFC3339),
+			"credential": cred, // this will be hidden in the UI, but will be used to get the credential when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		cred, ok := rows[0].Data["credential"].(*client.RegistryCredential)
+		if !ok {
+			return nil
+		}
+
+		return selectCredential(ctx, cred)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadRegistryCredentials, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &RegistryCredentialList{
+		table: t,
+	}
+}
+
+func (rl *RegistryCredentialList) Init() tea.Cmd {
+	return rl.table.Init()
+}
+
+func (rl *RegistryCredentialList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return rl.table.Update(msg)
+}
+
+func (rl *RegistryCredentialList) View() string {
+	return rl.table.View()
+}
+
+type RegistryCredentialUsageInput struct {
+	CredentialID string `cli:"arg:0"`
+}
+
+// LoadRegistryCredentialUsage lists the services that pull their image with each credential. When a credential ID
+// is given, only that credential is returned.
+func LoadRegistryCredentialUsage(ctx context.Context, in RegistryCredentialUsageInput) ([]*registrycredential.Usage, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := registrycredential.NewRepo(c)
+
+	var creds []*client.RegistryCredential
+	if in.CredentialID != "" {
+		cred, err := repo.GetRegistryCredential(ctx, in.CredentialID)
+		if err != nil {
+			return nil, err
+		}
+		creds = []*client.RegistryCredential{cred}
+	} else {
+		creds, err = repo.ListRegistryCredentials(ctx, &client.ListRegistryCredentialsParams{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	services, err := listServices(ctx, ServiceInput{IncludePreviews: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return registrycredential.WithServices(creds, services), nil
+}
+
+type RegistryCredentialUsageList struct {
+	table *tui.Table[*registrycredential.Usage]
+}
+
+func NewRegistryCredentialUsageList(ctx context.Context, input RegistryCredentialUsageInput, selectService OnSelectFuncT[*service.Model], opts ...tui.TableOption[*registrycredential.Usage]) *RegistryCredentialUsageList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Credential", "Credential", 2).WithFiltered(true),
+		btable.NewColumn("Registry", "Registry", 16).WithFiltered(true),
+		btable.NewFlexColumn("Service", "Service", 3).WithFiltered(true),
+		btable.NewFlexColumn("Image", "Image", 3).WithFiltered(true),
+		btable.NewColumn("ID", "Service ID", 25).WithFiltered(true),
+	}
+
+	// usages are split by service before they reach the table, so each row has at most one service
+	createRowFunc := func(u *registrycredential.Usage) btable.Row {
+		row := registrycredential.UsageRows(u)[0]
+		data := btable.RowData{
+			"Credential": row[0],
+			"Registry":   row[1],
+			"Service":    row[2],
+			"Image":      row[3],
+			"ID":         row[4],
+		}
+		if len(u.Services) > 0 {
+			data["service"] = u.Services[0] // this will be hidden in the UI, but will be used to get the service when selected
+		}
+		return btable.NewRow(data)
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		svc, ok := rows[0].Data["service"].(*service.Model)
+		if !ok {
+			return nil
+		}
+
+		return selectService(ctx, svc)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, func(ctx context.Context, in RegistryCredentialUsageInput) ([]*registrycredential.Usage, error) {
+			usages, err := LoadRegistryCredentialUsage(ctx, in)
+			if err != nil {
+				return nil, err
+			}
+			return splitUsageByService(usages), nil
+		}, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &RegistryCredentialUsageList{
+		table: t,
+	}
+}
+
+// splitUsageByService returns one usage per service so each service gets its own row in the table
+func splitUsageByService(usages []*registrycredential.Usage) []*registrycredential.Usage {
+	var split []*registrycredential.Usage
+	for _, u := range usages {
+		if len(u.Services) == 0 {
+			split = append(split, u)
+			continue
+		}
+		for _, svc := range u.Services {
+			split = append(split, &registrycredential.Usage{Credential: u.Credential, Services: []*service.Model{svc}})
+		}
+	}
+	return split
+}
+
+func (ul *RegistryCredentialUsageList) Init() tea.Cmd {
+	return ul.table.Init()
+}
+
+func (ul *RegistryCredentialUsageList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return ul.table.Update(msg)
+}
+
+func (ul *RegistryCredentialUsageList) View() string {
+	return ul.table.View()
+}
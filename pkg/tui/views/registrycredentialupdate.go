@@ -0,0 +1,322 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/registrycredential"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+// readToken reads a token from a file, or from stdin when no file is given. Tokens are never accepted as
+// flags so they don't end up in shell history.
+func readToken(tokenFile string) (string, error) {
+	if tokenFile != "" && tokenFile != "-" {
+		f, err := os.Open(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token file: %w", err)
+		}
+		defer f.Close()
+		return registrycredential.ReadToken(f)
+	}
+
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return "", err
+	}
+	if stat.Mode()&os.ModeCharDevice != 0 {
+		return "", errors.New("no token provided, pipe the token to stdin or use --token-file")
+	}
+	return registrycredential.ReadToken(os.Stdin)
+}
+
+type RegistryCredentialCreateInput struct {
+	Name      string `cli:"name"`
+	Registry  string `cli:"registry"`
+	Username  string `cli:"username"`
+	TokenFile string `cli:"token-file"`
+}
+
+func (in RegistryCredentialCreateInput) Validate() error {
+	if in.Name == "" {
+		return errors.New("name is required")
+	}
+	if in.Registry == "" {
+		return errors.New("registry is required")
+	}
+	if in.Username == "" {
+		return errors.New("username is required")
+	}
+	return nil
+}
+
+func CreateRegistryCredential(ctx context.Context, input RegistryCredentialCreateInput) (*client.RegistryCredential, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	token, err := readToken(input.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+	return createRegistryCredential(ctx, input, token)
+}
+
+func createRegistryCredential(ctx context.Context, input RegistryCredentialCreateInput, token string) (*client.RegistryCredential, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	cred, err := registrycredential.NewRepo(c).CreateRegistryCredential(ctx, client.CreateRegistryCredentialJSONRequestBody{
+		Name:      input.Name,
+		Registry:  client.RegistryCredentialRegistry(input.Registry),
+		Username:  input.Username,
+		AuthToken: token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry credential: %w", err)
+	}
+	return cred, nil
+}
+
+type RegistryCredentialCreateView struct {
+	formAction *tui.FormWithAction[*client.RegistryCredential]
+}
+
+func NewRegistryCredentialCreateView(ctx context.Context, input *RegistryCredentialCreateInput, cobraCmd *cobra.Command, action func(*client.RegistryCredential) tea.Cmd) *RegistryCredentialCreateView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	var token string
+	fields = append(fields, tokenField(&token, "The registry token. Leave empty to read it from token-file"))
+
+	return &RegistryCredentialCreateView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					var createInput RegistryCredentialCreateInput
+					err := command.StructFromFormValues(values, &createInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, func(ctx context.Context, in RegistryCredentialCreateInput) (*client.RegistryCredential, error) {
+						t, err := tokenFromForm(token, in.TokenFile)
+						if err != nil {
+							return nil, err
+						}
+						return createRegistryCredential(ctx, in, t)
+					}, createInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *RegistryCredentialCreateView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *RegistryCredentialCreateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *RegistryCredentialCreateView) View() string {
+	return v.formAction.View()
+}
+
+// tokenField is a masked input for the token in interactive forms. It isn't backed by a flag, so it is added to the
+// fields built from the command's flags.
+func tokenField(token *string, description string) huh.Field {
+	return huh.NewInput().
+		Key("token").
+		Title("token").
+		Description(description).
+		EchoMode(huh.EchoModePassword).
+		Value(token)
+}
+
+func tokenFromForm(token, tokenFile string) (string, error) {
+	if token != "" {
+		return token, nil
+	}
+	if tokenFile == "" {
+		return "", errors.New("token is required")
+	}
+	return readToken(tokenFile)
+}
+
+type RegistryCredentialRotateInput struct {
+	CredentialID string `cli:"arg:0"`
+	Username     string `cli:"username"`
+	TokenFile    string `cli:"token-file"`
+}
+
+func RotateRegistryCredential(ctx context.Context, input RegistryCredentialRotateInput) (*registrycredential.Usage, error) {
+	token, err := readToken(input.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+	return rotateRegistryCredential(ctx, input, token)
+}
+
+// rotateRegistryCredential replaces the token and returns the services that will pull with it. Name and registry
+// are kept, and so is the username unless a new one is given.
+func rotateRegistryCredential(ctx context.Context, input RegistryCredentialRotateInput, token string) (*registrycredential.Usage, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	repo := registrycredential.NewRepo(c)
+
+	cred, err := repo.GetRegistryCredential(ctx, input.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	username := cred.Username
+	if input.Username != "" {
+		username = input.Username
+	}
+
+	cred, err = repo.UpdateRegistryCredential(ctx, input.CredentialID, client.UpdateRegistryCredentialJSONRequestBody{
+		Name:      cred.Name,
+		Registry:  cred.Registry,
+		Username:  username,
+		AuthToken: token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate registry credential: %w", err)
+	}
+
+	services, err := listServices(ctx, ServiceInput{IncludePreviews: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return registrycredential.WithServices([]*client.RegistryCredential{cred}, services)[0], nil
+}
+
+// RegistryCredentialRotateView asks for the new token, the action receives a summary of the affected services
+type RegistryCredentialRotateView struct {
+	formAction *tui.FormWithAction[string]
+}
+
+func NewRegistryCredentialRotateView(ctx context.Context, input *RegistryCredentialRotateInput, cobraCmd *cobra.Command, action func(string) tea.Cmd) *RegistryCredentialRotateView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	var token string
+	fields = append(fields, tokenField(&token, "The registry token. Leave empty to read it from token-file"))
+
+	return &RegistryCredentialRotateView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					rotateInput := RegistryCredentialRotateInput{CredentialID: input.CredentialID}
+					err := command.StructFromFormValues(values, &rotateInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, func(ctx context.Context, in RegistryCredentialRotateInput) (string, error) {
+						t, err := tokenFromForm(token, in.TokenFile)
+						if err != nil {
+							return "", err
+						}
+						u, err := rotateRegistryCredential(ctx, in, t)
+						if err != nil {
+							return "", err
+						}
+						return registrycredential.RotateSummary(u), nil
+					}, rotateInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *RegistryCredentialRotateView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *RegistryCredentialRotateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *RegistryCredentialRotateView) View() string {
+	return v.formAction.View()
+}
+
+type RegistryCredentialDeleteInput struct {
+	CredentialID string `cli:"arg:0"`
+}
+
+func DeleteRegistryCredential(ctx context.Context, input RegistryCredentialDeleteInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := registrycredential.NewRepo(c).DeleteRegistryCredential(ctx, input.CredentialID); err != nil {
+		return "", fmt.Errorf("failed to delete registry credential: %w", err)
+	}
+	return fmt.Sprintf("Registry credential %s successfully deleted", input.CredentialID), nil
+}
+
+func RequireConfirmationForDeleteRegistryCredential(ctx context.Context, input RegistryCredentialDeleteInput) (string, error) {
+	usages, err := LoadRegistryCredentialUsage(ctx, RegistryCredentialUsageInput{CredentialID: input.CredentialID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry credential: %w", err)
+	}
+
+	u := usages[0]
+	if len(u.Services) == 0 {
+		return fmt.Sprintf("Are you sure you want to delete registry credential %s? No services use it.", u.Credential.Name), nil
+	}
+	return fmt.Sprintf(
+		"Are you sure you want to delete registry credential %s? %d services use it and will fail to pull their image on the next deploy.",
+		u.Credential.Name, len(u.Services),
+	), nil
+}
+
+type RegistryCredentialDeleteView struct {
+	model *tui.SimpleModel
+}
+
+func NewRegistryCredentialDeleteView(ctx context.Context, input RegistryCredentialDeleteInput) *RegistryCredentialDeleteView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, DeleteRegistryCredential, input),
+		func() (string, error) { return RequireConfirmationForDeleteRegistryCredential(ctx, input) },
+	))
+
+	return &RegistryCredentialDeleteView{
+		model: model,
+	}
+}
+
+func (v *RegistryCredentialDeleteView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *RegistryCredentialDeleteView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *RegistryCredentialDeleteView) View() string {
+	return v.model.View()
+}
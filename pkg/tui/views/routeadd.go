@@ -0,0 +1,103 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type RouteAddInput struct {
+	ServiceID   string `cli:"arg:0"`
+	Type        string `cli:"type"`
+	Source      string `cli:"source"`
+	Destination string `cli:"destination"`
+}
+
+func (r RouteAddInput) ToBody() (client.RoutePost, error) {
+	if r.ServiceID == "" {
+		return client.RoutePost{}, errors.New("service ID is required")
+	}
+	if !strings.HasPrefix(r.Source, "/") {
+		return client.RoutePost{}, errors.New("source must be a path starting with /")
+	}
+	if r.Destination == "" {
+		return client.RoutePost{}, errors.New("destination is required")
+	}
+
+	routeType := client.RouteType(r.Type)
+	if routeType == "" {
+		routeType = client.RouteTypeRedirect
+	}
+
+	return client.RoutePost{
+		Type:        routeType,
+		Source:      r.Source,
+		Destination: r.Destination,
+	}, nil
+}
+
+func AddRoute(ctx context.Context, input RouteAddInput) (*client.Route, error) {
+	body, err := input.ToBody()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return route.NewRepo(c).AddRoute(ctx, input.ServiceID, body)
+}
+
+type RouteAddView struct {
+	formAction *tui.FormWithAction[*client.Route]
+}
+
+func NewRouteAddView(
+	ctx context.Context,
+	input *RouteAddInput,
+	cobraCmd *cobra.Command,
+	action func(r *client.Route) tea.Cmd,
+) *RouteAddView {
+	fields, values := command.HuhFormFields(cobraCmd, input)
+
+	return &RouteAddView{
+		formAction: tui.NewFormWithAction(
+			tui.NewFormAction(
+				action,
+				func() tea.Msg {
+					var addRouteInput RouteAddInput
+					err := command.StructFromFormValues(values, &addRouteInput)
+					if err != nil {
+						return tui.ErrorMsg{Err: err}
+					}
+					return command.LoadCmd(ctx, AddRoute, addRouteInput)()
+				},
+			),
+			huh.NewForm(huh.NewGroup(fields...)),
+		),
+	}
+}
+
+func (v *RouteAddView) Init() tea.Cmd {
+	return v.formAction.Init()
+}
+
+func (v *RouteAddView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.formAction.Update(msg)
+}
+
+func (v *RouteAddView) View() string {
+	return v.formAction.View()
+}
@@ -0,0 +1,121 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/rulefile"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type RouteApplyInput struct {
+	ServiceID  string `cli:"arg:0"`
+	File       string `cli:"file"`
+	Format     string `cli:"format"`
+	AllowEmpty bool   `cli:"allow-empty"`
+}
+
+func readRouteImport(input RouteApplyInput) (*route.Import, error) {
+	if input.File == "" {
+		return nil, errors.New("--file is required")
+	}
+
+	data, err := os.ReadFile(input.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", input.File, err)
+	}
+
+	format := input.Format
+	if format == "" {
+		format = rulefile.DetectFormat(input.File)
+	}
+
+	imported, err := route.Parse(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", input.File, err)
+	}
+
+	// applying no rules removes every rule on the service, which is more likely a mistake than intended
+	if len(imported.Routes) == 0 && !input.AllowEmpty {
+		return nil, fmt.Errorf("%s does not define any rules, set --allow-empty to remove all rules from the service", input.File)
+	}
+	return imported, nil
+}
+
+// ApplyRoutes replaces all the redirect and rewrite rules of the service with the rules in the file in a single request
+func ApplyRoutes(ctx context.Context, input RouteApplyInput) (*route.ApplyResult, error) {
+	imported, err := readRouteImport(input)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	routes, err := route.NewRepo(c).PutRoutes(ctx, input.ServiceID, imported.Routes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply routes: %w", err)
+	}
+
+	return &route.ApplyResult{Routes: routes, Skipped: imported.Skipped}, nil
+}
+
+func RequireConfirmationForApplyRoutes(ctx context.Context, input RouteApplyInput) (string, error) {
+	imported, err := readRouteImport(input)
+	if err != nil {
+		return "", err
+	}
+
+	existing, err := LoadRoutes(ctx, RouteListInput{ServiceID: input.ServiceID})
+	if err != nil {
+		return "", fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	msg := fmt.Sprintf("Replace the %d existing rules on service %s with %d rules from %s?", len(existing), input.ServiceID, len(imported.Routes), input.File)
+	if len(imported.Skipped) > 0 {
+		msg += fmt.Sprintf(" %d unsupported rules will be skipped.", len(imported.Skipped))
+	}
+	return msg, nil
+}
+
+type RouteApplyView struct {
+	model *tui.SimpleModel
+}
+
+func NewRouteApplyView(ctx context.Context, input RouteApplyInput) *RouteApplyView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, func(ctx context.Context, input RouteApplyInput) (string, error) {
+			res, err := ApplyRoutes(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return route.ApplySummary(input.ServiceID, res), nil
+		}, input),
+		func() (string, error) { return RequireConfirmationForApplyRoutes(ctx, input) },
+	))
+
+	return &RouteApplyView{
+		model: model,
+	}
+}
+
+func (v *RouteApplyView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *RouteApplyView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *RouteApplyView) View() string {
+	return v.model.View()
+}
@@ -0,0 +1,30 @@
+package views_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renderinc/cli/pkg/tui/views"
+)
+
+func TestApplyRoutesWithoutRules(t *testing.T) {
+	for name, content := range map[string]string{
+		"empty.yaml":   "",
+		"routes.yaml":  "routes: []\n",
+		"_redirects":   "# only comments\n",
+		"skipped.list": "/gone / 410\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			_, err := views.ApplyRoutes(context.Background(), views.RouteApplyInput{ServiceID: "srv-1", File: path})
+			assert.ErrorContains(t, err, "--allow-empty")
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type RouteListInput struct {
+	ServiceID string `cli:"arg:0"`
+}
+
+func LoadRoutes(ctx context.Context, in RouteListInput) ([]*client.Route, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	routeRepo := route.NewRepo(c)
+
+	return routeRepo.ListRoutes(ctx, in.ServiceID, &client.ListRoutesParams{})
+}
+
+type RouteList struct {
+	table *tui.Table[*client.Route]
+}
+
+func NewRouteList(ctx context.Context, input RouteListInput, selectRoute OnSelectFuncT[*client.Route], opts ...tui.TableOption[*client.Route]) *RouteList {
+	columns := []btable.Column{
+		btable.NewColumn("Priority", "Priority", 10),
+		btable.NewColumn("Type", "Type", 10).WithFiltered(true),
+		btable.NewFlexColumn("Source", "Source", 3).WithFiltered(true),
+		btable.NewFlexColumn("Destination", "Destination", 3).WithFiltered(true),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(r *client.Route) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Priority":    strconv.Itoa(r.Priority),
+			"Type":        string(r.Type),
+			"Source":      r.Source,
+			"Destination": r.Destination,
+			"ID":          r.Id,
+			"route":       r, // this will be hidden in the UI, but will be used to get the route when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		r, ok := rows[0].Data["route"].(*client.Route)
+		if !ok {
+			return nil
+		}
+
+		return selectRoute(ctx, r)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, LoadRoutes, input),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &RouteList{
+		table: t,
+	}
+}
+
+func (rl *RouteList) Init() tea.Cmd {
+	return rl.table.Init()
+}
+
+func (rl *RouteList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return rl.table.Update(msg)
+}
+
+func (rl *RouteList) View() string {
+	return rl.table.View()
+}
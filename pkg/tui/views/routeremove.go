@@ -0,0 +1,72 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renderinc/cli/pkg/client"
+	"github.com/renderinc/cli/pkg/command"
+	"github.com/renderinc/cli/pkg/route"
+	"github.com/renderinc/cli/pkg/tui"
+)
+
+type RouteRemoveInput struct {
+	ServiceID string `cli:"arg:0"`
+	RouteID   string `cli:"arg:1"`
+}
+
+type RouteRemoveView struct {
+	model *tui.SimpleModel
+}
+
+func RemoveRoute(ctx context.Context, input RouteRemoveInput) (string, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := route.NewRepo(c).DeleteRoute(ctx, input.ServiceID, input.RouteID); err != nil {
+		return "", fmt.Errorf("failed to remove route: %w", err)
+	}
+	return fmt.Sprintf("Route %s successfully removed", input.RouteID), nil
+}
+
+func RequireConfirmationForRemoveRoute(ctx context.Context, input RouteRemoveInput) (string, error) {
+	routes, err := LoadRoutes(ctx, RouteListInput{ServiceID: input.ServiceID})
+	if err != nil {
+		return "", fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	for _, r := range routes {
+		if r.Id == input.RouteID {
+			return fmt.Sprintf("Are you sure you want to remove the %s rule %s -> %s?", r.Type, r.Source, r.Destination), nil
+		}
+	}
+
+	return "", fmt.Errorf("route %s not found on service %s", input.RouteID, input.ServiceID)
+}
+
+func NewRouteRemoveView(ctx context.Context, input RouteRemoveInput) *RouteRemoveView {
+	model := tui.NewSimpleModel(command.WrapInConfirm(
+		command.LoadCmd(ctx, RemoveRoute, input),
+		func() (string, error) { return RequireConfirmationForRemoveRoute(ctx, input) },
+	))
+
+	return &RouteRemoveView{
+		model: model,
+	}
+}
+
+func (v *RouteRemoveView) Init() tea.Cmd {
+	return v.model.Init()
+}
+
+func (v *RouteRemoveView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.model.Update(msg)
+}
+
+func (v *RouteRemoveView) View() string {
+	return v.model.View()
+}